@@ -0,0 +1,322 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// albumColumns are the columns selected for every album query, in the order
+// queryAlbums expects them.
+const albumColumns = "id, title, COALESCE(description, ''), COALESCE(cover_photo_id, ''), COALESCE(user_id, ''), created_at, modified_at"
+
+// Album is an ordered collection of photos.
+type Album struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	CoverID     string    `json:"cover_id"`
+	User        User      `json:"user"`
+	Created     time.Time `json:"created"`
+	Modified    time.Time `json:"modified"`
+}
+
+// Save inserts or updates an album.
+func (a *Album) Save(ctx context.Context) error {
+	return a.save(ctx, db)
+}
+
+// Create saves a new album with the photos ids in it, in that order. The
+// album and its photos are saved together, so if the photos cannot be used no
+// album is made.
+func (a *Album) Create(ctx context.Context, ids []string) error {
+	if err := checkAttachable(ctx, ids); err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := a.save(ctx, tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := writeAttachedPhotos(ctx, tx, "album", a.ID, ids); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// save checks the album and writes it with ex.
+func (a *Album) save(ctx context.Context, ex execer) error {
+	if a.ID == "" {
+		uuid, err := uuid.NewRandom()
+		if err != nil {
+			return err
+		}
+		a.ID = uuid.String()
+	}
+
+	if a.Title == "" {
+		return fmt.Errorf("Title cannot be empty")
+	}
+
+	if a.CoverID != "" {
+		if _, err := GetPhoto(ctx, a.CoverID); err != nil {
+			return err
+		}
+	}
+
+	if a.Created.IsZero() {
+		a.Created = time.Now()
+	}
+
+	a.Modified = time.Now()
+
+	_, err := ex.ExecContext(
+		ctx,
+		`
+INSERT INTO albums(id, title, description, cover_photo_id, user_id, created_at, modified_at)
+VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), $5, $6, $7)
+ON CONFLICT (id) DO UPDATE
+SET (title, description, cover_photo_id, user_id, created_at, modified_at) = ($2, NULLIF($3, ''), NULLIF($4, ''), $5, $6, $7)
+WHERE albums.id = $1;
+`,
+		a.ID,
+		a.Title,
+		a.Description,
+		a.CoverID,
+		a.User.ID,
+		a.Created,
+		a.Modified)
+
+	return err
+}
+
+// CanEdit reports whether the current user can change this album. Its owner
+// and admins can.
+func (a *Album) CanEdit(ctx context.Context) bool {
+	u := GetUserFromContext(ctx)
+	if u == nil {
+		return false
+	}
+
+	return Role(u.Role) == RoleAdmin || u.ID == a.User.ID
+}
+
+// Cover returns the album's cover photo, or its first photo if it has no
+// cover set.
+func (a *Album) Cover(ctx context.Context) (*Photo, error) {
+	if a.CoverID != "" {
+		return GetPhoto(ctx, a.CoverID)
+	}
+
+	photos, err := attachedPhotos(ctx, "album", a.ID)
+	if err != nil || len(photos) == 0 {
+		return nil, err
+	}
+
+	return photos[0], nil
+}
+
+// Photos returns the photos in the album, in order.
+func (a *Album) Photos(ctx context.Context) ([]*Photo, error) {
+	return attachedPhotos(ctx, "album", a.ID)
+}
+
+// SetPhotos replaces the photos in the album with ids, in that order.
+func (a *Album) SetPhotos(ctx context.Context, ids []string) error {
+	if !a.CanEdit(ctx) {
+		return fmt.Errorf("forbidden")
+	}
+
+	if err := setAttachedPhotos(ctx, "album", a.ID, ids); err != nil {
+		return err
+	}
+
+	return a.Save(ctx)
+}
+
+// GetAlbum gets an album by ID from the database.
+func GetAlbum(ctx context.Context, id string) (*Album, error) {
+	albums, err := queryAlbums(ctx, "SELECT "+albumColumns+" FROM albums WHERE id = $1", id)
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("Error running get query: %+v", err)
+	case len(albums) == 0:
+		return nil, fmt.Errorf("No album %s", id)
+	default:
+		return albums[0], nil
+	}
+}
+
+// GetAlbums returns albums, most recently modified first.
+func GetAlbums(ctx context.Context, limit, offset int) ([]*Album, error) {
+	return queryAlbums(ctx, "SELECT "+albumColumns+" FROM albums ORDER BY modified_at DESC LIMIT $1 OFFSET $2", limit, offset)
+}
+
+// queryAlbums runs a query that selects albumColumns and returns the albums
+// found, with their users.
+func queryAlbums(ctx context.Context, query string, args ...interface{}) ([]*Album, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	albums := make([]*Album, 0)
+	for rows.Next() {
+		a := &Album{}
+		err := rows.Scan(&a.ID, &a.Title, &a.Description, &a.CoverID, &a.User.ID, &a.Created, &a.Modified)
+		if err != nil {
+			return nil, err
+		}
+		albums = append(albums, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, a := range albums {
+		if a.User.ID == "" {
+			continue
+		}
+
+		u, err := FindUser(ctx, a.User.ID)
+		if err != nil {
+			return nil, err
+		}
+		a.User = *u
+	}
+
+	return albums, nil
+}
+
+// Photos returns the photos attached to the post, in order.
+func (p *Post) Photos(ctx context.Context) ([]*Photo, error) {
+	return attachedPhotos(ctx, "post", p.ID)
+}
+
+// SetPhotos replaces the photos attached to the post with ids, in that order.
+func (p *Post) SetPhotos(ctx context.Context, ids []string) error {
+	return setAttachedPhotos(ctx, "post", p.ID, ids)
+}
+
+// Photos returns the photos attached to the page, in order.
+func (p *Page) Photos(ctx context.Context) ([]*Photo, error) {
+	return attachedPhotos(ctx, "page", p.ID)
+}
+
+// SetPhotos replaces the photos attached to the page with ids, in that order.
+// Only people who can edit the page can.
+func (p *Page) SetPhotos(ctx context.Context, ids []string) error {
+	if !p.CanEdit(ctx) {
+		return fmt.Errorf("forbidden")
+	}
+
+	return setAttachedPhotos(ctx, "page", p.ID, ids)
+}
+
+// attachedPhotos returns the photos attached to an album, post or page, in
+// order.
+func attachedPhotos(ctx context.Context, kind, targetID string) ([]*Photo, error) {
+	return queryPhotos(ctx, `
+SELECT `+photoColumns+`
+FROM photos
+JOIN photo_attachments ON photo_attachments.photo_id = photos.id
+WHERE photo_attachments.kind = $1 AND photo_attachments.target_id = $2
+ORDER BY photo_attachments.position ASC`, kind, targetID)
+}
+
+// checkAttachable makes sure ids are photos the current user can attach, with
+// none listed twice.
+func checkAttachable(ctx context.Context, ids []string) error {
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			return fmt.Errorf("photo %s is listed more than once", id)
+		}
+		seen[id] = true
+
+		p, err := GetPhoto(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if !p.CanAttach(ctx) {
+			return fmt.Errorf("forbidden")
+		}
+	}
+
+	return nil
+}
+
+// setAttachedPhotos replaces the photos attached to an album, post or page
+// with ids, in that order. Only photos the current user can attach can be
+// used.
+func setAttachedPhotos(ctx context.Context, kind, targetID string, ids []string) error {
+	if err := checkAttachable(ctx, ids); err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := writeAttachedPhotos(ctx, tx, kind, targetID, ids); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// writeAttachedPhotos replaces the photos attached to an album, post or page
+// with ids using ex, without checking them.
+func writeAttachedPhotos(ctx context.Context, ex execer, kind, targetID string, ids []string) error {
+	if _, err := ex.ExecContext(ctx, "DELETE FROM photo_attachments WHERE kind = $1 AND target_id = $2", kind, targetID); err != nil {
+		return err
+	}
+
+	_, err := ex.ExecContext(
+		ctx,
+		`
+INSERT INTO photo_attachments(kind, target_id, photo_id, position)
+SELECT $1, $2, photo_id, position
+FROM UNNEST($3::text[]) WITH ORDINALITY AS t(photo_id, position);
+`,
+		kind,
+		targetID,
+		pq.Array(ids))
+
+	return err
+}
+
+// attachPhoto adds a photo to the end of the photos attached to an album,
+// post or page.
+func attachPhoto(ctx context.Context, kind, targetID, photoID string) error {
+	_, err := db.ExecContext(
+		ctx,
+		`
+INSERT INTO photo_attachments(kind, target_id, photo_id, position)
+SELECT $1, $2, $3, COALESCE(MAX(position), 0) + 1
+FROM photo_attachments
+WHERE kind = $1 AND target_id = $2
+ON CONFLICT DO NOTHING;
+`,
+		kind,
+		targetID,
+		photoID)
+
+	return err
+}
@@ -0,0 +1,118 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPhotoCanAttach(t *testing.T) {
+	p := &Photo{User: User{ID: "owner"}}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want bool
+	}{
+		{"anonymous", context.Background(), false},
+		{"other user", WithUser(context.Background(), &User{ID: "other", Role: string(RoleNormal)}), false},
+		{"owner", WithUser(context.Background(), &User{ID: "owner", Role: string(RoleNormal)}), true},
+		{"admin", WithUser(context.Background(), &User{ID: "admin", Role: string(RoleAdmin)}), true},
+	}
+
+	for _, tc := range tests {
+		if got := p.CanAttach(tc.ctx); got != tc.want {
+			t.Errorf("%s: CanAttach = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestUploadPhotoForPostNeedsAdmin(t *testing.T) {
+	r := &mutationResolver{&Resolver{}}
+	ctx := WithUser(context.Background(), &User{ID: "user", Role: string(RoleNormal)})
+	postID := "1"
+
+	if _, err := r.UploadPhoto(ctx, Upload{}, nil, &postID); err == nil || err.Error() != "forbidden" {
+		t.Errorf("got %+v, want forbidden", err)
+	}
+}
+
+func TestCreateAlbumWithOthersPhotos(t *testing.T) {
+	testDB(t)
+
+	owner := testUser(t, RoleNormal)
+	other := testUser(t, RoleNormal)
+
+	p := &Photo{User: *owner, ContentType: "image/png"}
+	if err := p.Save(WithUser(context.Background(), owner)); err != nil {
+		t.Fatalf("Save: %+v", err)
+	}
+
+	r := &mutationResolver{&Resolver{}}
+	ctx := WithUser(context.Background(), other)
+
+	if _, err := r.CreateAlbum(ctx, NewAlbum{Title: "Not mine", PhotoIds: []string{p.ID}}); err == nil {
+		t.Error("expected an error adding someone else's photo")
+	}
+
+	if _, err := r.CreateAlbum(ctx, NewAlbum{Title: "Not my cover", CoverPhotoID: &p.ID}); err == nil {
+		t.Error("expected an error using someone else's photo as a cover")
+	}
+
+	var n int
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM albums WHERE user_id = $1", other.ID).Scan(&n); err != nil {
+		t.Fatalf("could not count albums: %+v", err)
+	}
+
+	if n != 0 {
+		t.Errorf("%d albums were made, want none", n)
+	}
+
+	a, err := r.CreateAlbum(WithUser(context.Background(), owner), NewAlbum{Title: "Mine", PhotoIds: []string{p.ID}})
+	if err != nil {
+		t.Fatalf("CreateAlbum: %+v", err)
+	}
+
+	photos, err := a.Photos(ctx)
+	if err != nil {
+		t.Fatalf("Photos: %+v", err)
+	}
+
+	if len(photos) != 1 || photos[0].ID != p.ID {
+		t.Errorf("got %+v, want the photo", photos)
+	}
+}
+
+func TestGetAlbumDoesNotUpdateUsers(t *testing.T) {
+	testDB(t)
+
+	owner := testUser(t, RoleNormal)
+	ctx := WithUser(context.Background(), owner)
+
+	a := &Album{Title: "Unchanged", User: *owner}
+	if err := a.Create(ctx, nil); err != nil {
+		t.Fatalf("Create: %+v", err)
+	}
+
+	before, err := FindUser(ctx, owner.ID)
+	if err != nil {
+		t.Fatalf("FindUser: %+v", err)
+	}
+
+	got, err := GetAlbum(context.Background(), a.ID)
+	if err != nil {
+		t.Fatalf("GetAlbum: %+v", err)
+	}
+
+	if got.User.ID != owner.ID {
+		t.Errorf("album owned by %q, want %q", got.User.ID, owner.ID)
+	}
+
+	after, err := FindUser(ctx, owner.ID)
+	if err != nil {
+		t.Fatalf("FindUser: %+v", err)
+	}
+
+	if !after.Modified.Equal(before.Modified) {
+		t.Errorf("getting the album updated its owner: modified %v, was %v", after.Modified, before.Modified)
+	}
+}
@@ -2,22 +2,39 @@ package graphql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+// bookColumns are the columns selected for every book query, in the order
+// scanBook expects them.
+const bookColumns = `id, COALESCE(title, ''), COALESCE(goodreads_id, ''), COALESCE(authors, '{}'), COALESCE(isbn, ''), shelf, started, finished,
+  rating, COALESCE(review, ''), COALESCE(pages, 0), created_at, modified_at, COALESCE(open_library_id, '')`
+
 // Book is a book on Goodreads.
 type Book struct {
 	ID          string `json:"id"`
 	GoodreadsID string
 	Title       string `json:"title"`
 	Link        string
-	Authors     []string
-	ISBN        string
-	Created     time.Time `json:"created"`
-	Modified    time.Time `json:"modified"`
+	Authors     []string   `json:"authors"`
+	ISBN        string     `json:"isbn"`
+	Shelf       Shelf      `json:"shelf"`
+	Started     *time.Time `json:"started"`
+	Finished    *time.Time `json:"finished"`
+	Rating      *int       `json:"rating"`
+	Review      string     `json:"review"`
+	Pages       int        `json:"pages"`
+	Created     time.Time  `json:"created"`
+	Modified    time.Time  `json:"modified"`
+
+	// OpenLibraryID is the key of the book on Open Library, like
+	// "/books/OL7353617M".
+	OpenLibraryID string
 }
 
 // IsLinkable exists to show that this method implements the Linkable type in
@@ -34,6 +51,18 @@ func (b *Book) Save(ctx context.Context) error {
 		b.ID = uuid.String()
 	}
 
+	if b.Shelf == "" {
+		b.Shelf = ShelfToRead
+	}
+
+	if !b.Shelf.IsValid() {
+		return fmt.Errorf("%s is not a valid shelf", b.Shelf)
+	}
+
+	if b.Rating != nil && (*b.Rating < 1 || *b.Rating > 5) {
+		return fmt.Errorf("rating must be between 1 and 5")
+	}
+
 	if b.Created.IsZero() {
 		b.Created = time.Now()
 	}
@@ -43,24 +72,144 @@ func (b *Book) Save(ctx context.Context) error {
 	if _, err := db.ExecContext(
 		ctx,
 		`
-INSERT INTO books(id, title, goodreads_id, created_at, modified_at)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO books(id, title, goodreads_id, created_at, modified_at, authors, isbn, shelf, started, finished, rating, review, pages, open_library_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NULLIF($13, 0), NULLIF($14, ''))
 ON CONFLICT (id) DO UPDATE
-SET (title, goodreads_id, created_at, modified_at) = ($2, $3, $4, $5)
+SET (title, goodreads_id, created_at, modified_at, authors, isbn, shelf, started, finished, rating, review, pages, open_library_id) = ($2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NULLIF($13, 0), NULLIF($14, ''))
 WHERE books.id = $1;
 `,
 		b.ID,
 		b.Title,
 		b.GoodreadsID,
 		b.Created,
-		b.Modified); err != nil {
+		b.Modified,
+		pq.Array(b.Authors),
+		b.ISBN,
+		b.Shelf,
+		b.Started,
+		b.Finished,
+		b.Rating,
+		b.Review,
+		b.Pages,
+		b.OpenLibraryID); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// URI returns an absolute link to this book.
+// URI returns an absolute link to this book, on Goodreads if we know its
+// Goodreads ID and on Open Library otherwise.
 func (b *Book) URI() URI {
-	return NewURI(fmt.Sprintf("https://www.goodreads.com/book/show/%s", b.GoodreadsID))
+	switch {
+	case b.GoodreadsID != "":
+		return NewURI(fmt.Sprintf("https://www.goodreads.com/book/show/%s", b.GoodreadsID))
+	case b.OpenLibraryID != "":
+		return NewURI(fmt.Sprintf("https://openlibrary.org%s", b.OpenLibraryID))
+	case b.ISBN != "":
+		return NewURI(fmt.Sprintf("https://openlibrary.org/isbn/%s", b.ISBN))
+	default:
+		return NewURI("")
+	}
+}
+
+type bookScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanBook(row bookScanner) (*Book, error) {
+	b := new(Book)
+	var rating sql.NullInt64
+	err := row.Scan(
+		&b.ID,
+		&b.Title,
+		&b.GoodreadsID,
+		pq.Array(&b.Authors),
+		&b.ISBN,
+		&b.Shelf,
+		&b.Started,
+		&b.Finished,
+		&rating,
+		&b.Review,
+		&b.Pages,
+		&b.Created,
+		&b.Modified,
+		&b.OpenLibraryID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if rating.Valid {
+		r := int(rating.Int64)
+		b.Rating = &r
+	}
+
+	return b, nil
+}
+
+// GetBook gets a book by ID from the database.
+func GetBook(ctx context.Context, id string) (*Book, error) {
+	b, err := findBook(ctx, id)
+	switch {
+	case err != nil:
+		return nil, err
+	case b == nil:
+		return nil, fmt.Errorf("No book %s", id)
+	default:
+		return b, nil
+	}
+}
+
+// findBook gets a book by ID from the database, returning nil if there is no
+// such book.
+func findBook(ctx context.Context, id string) (*Book, error) {
+	row := db.QueryRowContext(ctx, "SELECT "+bookColumns+" FROM books WHERE id = $1", id)
+	b, err := scanBook(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("Error running get query: %+v", err)
+	default:
+		return b, nil
+	}
+}
+
+// GetBooks returns books on a shelf, or all books if shelf is nil. Books are
+// ordered by when they were finished, then started, then last modified, most
+// recent first.
+func GetBooks(ctx context.Context, shelf *Shelf, limit, offset int) ([]*Book, error) {
+	query := `
+SELECT ` + bookColumns + `
+FROM books
+WHERE ($1 = '' OR shelf = $1)
+ORDER BY finished DESC NULLS LAST, started DESC NULLS LAST, modified_at DESC
+LIMIT $2 OFFSET $3`
+
+	s := ""
+	if shelf != nil {
+		s = shelf.String()
+	}
+
+	rows, err := db.QueryContext(ctx, query, s, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	books := make([]*Book, 0)
+	for rows.Next() {
+		b, err := scanBook(rows)
+		if err != nil {
+			return nil, err
+		}
+		books = append(books, b)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return books, nil
 }
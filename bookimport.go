@@ -0,0 +1,351 @@
+package graphql
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// goodreadsDateFormat is the format of dates in a Goodreads library export.
+const goodreadsDateFormat = "2006/01/02"
+
+// goodreadsShelves maps Goodreads exclusive shelves onto ours.
+var goodreadsShelves = map[string]Shelf{
+	"to-read":           ShelfToRead,
+	"currently-reading": ShelfReading,
+	"read":              ShelfRead,
+}
+
+// importedBook is a book read from an export, along with every ISBN we know
+// for it so it can be matched against books we already have.
+type importedBook struct {
+	book  *Book
+	isbns []string
+}
+
+// ImportBooks reads a library export in the given format and saves every book
+// in it. Books that match an existing book by Goodreads ID, Open Library ID or
+// ISBN update that book, keeping its ID and any fields the export leaves empty.
+// It returns the number of books saved.
+func ImportBooks(ctx context.Context, format BookImportFormat, r io.Reader) (int, error) {
+	var books []*importedBook
+	var err error
+
+	switch format {
+	case BookImportFormatGoodreads:
+		books, err = parseGoodreadsCSV(r)
+	case BookImportFormatOpenlibrary:
+		books, err = parseOpenLibraryJSON(r)
+	default:
+		return 0, fmt.Errorf("%s is not a valid BookImportFormat", format)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	for i, ib := range books {
+		b, err := findImportedBook(ctx, ib)
+		if err != nil {
+			return i, err
+		}
+
+		if b == nil {
+			b = ib.book
+		} else {
+			b.merge(ib.book)
+		}
+
+		if err := b.Save(ctx); err != nil {
+			return i, fmt.Errorf("could not save book %q: %+v", b.Title, err)
+		}
+	}
+
+	return len(books), nil
+}
+
+// findImportedBook returns the existing book with the same Goodreads ID, or
+// failing that the same Open Library ID, or failing that one of the same
+// ISBNs. A book with none of those is matched by title and authors. It returns
+// nil if there is no such book.
+func findImportedBook(ctx context.Context, ib *importedBook) (*Book, error) {
+	query := `
+SELECT ` + bookColumns + `
+FROM books
+WHERE ($1 <> '' AND goodreads_id = $1)
+  OR ($2 <> '' AND open_library_id = $2)
+  OR isbn = ANY($3)
+  OR ($1 = '' AND $2 = '' AND cardinality($3::text[]) = 0 AND title = $4 AND COALESCE(authors, '{}') = $5)
+ORDER BY (goodreads_id = $1) DESC NULLS LAST, (open_library_id = $2) DESC NULLS LAST
+LIMIT 1`
+
+	authors := ib.book.Authors
+	if authors == nil {
+		authors = []string{}
+	}
+
+	row := db.QueryRowContext(ctx, query, ib.book.GoodreadsID, ib.book.OpenLibraryID, pq.Array(ib.isbns), ib.book.Title, pq.Array(authors))
+	b, err := scanBook(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("Error running get query: %+v", err)
+	default:
+		return b, nil
+	}
+}
+
+// merge copies every field that is set on other onto b.
+func (b *Book) merge(other *Book) {
+	if other.GoodreadsID != "" {
+		b.GoodreadsID = other.GoodreadsID
+	}
+
+	if other.OpenLibraryID != "" {
+		b.OpenLibraryID = other.OpenLibraryID
+	}
+
+	if other.Title != "" {
+		b.Title = other.Title
+	}
+
+	if len(other.Authors) > 0 {
+		b.Authors = other.Authors
+	}
+
+	if other.ISBN != "" {
+		b.ISBN = other.ISBN
+	}
+
+	if other.Shelf != "" {
+		b.Shelf = other.Shelf
+	}
+
+	if other.Started != nil {
+		b.Started = other.Started
+	}
+
+	if other.Finished != nil {
+		b.Finished = other.Finished
+	}
+
+	if other.Rating != nil {
+		b.Rating = other.Rating
+	}
+
+	if other.Review != "" {
+		b.Review = other.Review
+	}
+
+	if other.Pages > 0 {
+		b.Pages = other.Pages
+	}
+}
+
+// parseGoodreadsCSV reads the CSV from "Export Library" on Goodreads.
+func parseGoodreadsCSV(r io.Reader) ([]*importedBook, error) {
+	cr := csv.NewReader(r)
+	cr.LazyQuotes = true
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("could not read header: %+v", err)
+	}
+
+	cols := map[string]int{}
+	for i, h := range header {
+		cols[strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))] = i
+	}
+
+	for _, required := range []string{"Book Id", "Title"} {
+		if _, ok := cols[required]; !ok {
+			return nil, fmt.Errorf("not a Goodreads export: missing %q column", required)
+		}
+	}
+
+	books := []*importedBook{}
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		get := func(name string) string {
+			i, ok := cols[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		b := &Book{
+			GoodreadsID: get("Book Id"),
+			Title:       get("Title"),
+			Shelf:       goodreadsShelves[get("Exclusive Shelf")],
+			Review:      get("My Review"),
+		}
+
+		if a := get("Author"); a != "" {
+			b.Authors = append(b.Authors, a)
+		}
+		for _, a := range strings.Split(get("Additional Authors"), ",") {
+			if a = strings.TrimSpace(a); a != "" {
+				b.Authors = append(b.Authors, a)
+			}
+		}
+
+		isbns := []string{}
+		for _, col := range []string{"ISBN13", "ISBN"} {
+			if isbn := cleanISBN(get(col)); isbn != "" {
+				isbns = append(isbns, isbn)
+			}
+		}
+		if len(isbns) > 0 {
+			b.ISBN = isbns[0]
+		}
+
+		if rating, err := strconv.Atoi(get("My Rating")); err == nil && rating > 0 {
+			b.Rating = &rating
+		}
+
+		if pages, err := strconv.Atoi(get("Number of Pages")); err == nil {
+			b.Pages = pages
+		}
+
+		if t, err := time.Parse(goodreadsDateFormat, get("Date Read")); err == nil {
+			b.Finished = &t
+		}
+
+		books = append(books, &importedBook{book: b, isbns: isbns})
+	}
+
+	return books, nil
+}
+
+// openLibraryRecord is the subset of an Open Library edition or search result
+// that we use.
+type openLibraryRecord struct {
+	Key        string   `json:"key"`
+	Title      string   `json:"title"`
+	Subtitle   string   `json:"subtitle"`
+	ISBN13     []string `json:"isbn_13"`
+	ISBN10     []string `json:"isbn_10"`
+	ISBN       []string `json:"isbn"`
+	AuthorName []string `json:"author_name"`
+	Authors    []struct {
+		Name string `json:"name"`
+	} `json:"authors"`
+	ByStatement string `json:"by_statement"`
+	Identifiers struct {
+		Goodreads []string `json:"goodreads"`
+	} `json:"identifiers"`
+	IDGoodreads   []string `json:"id_goodreads"`
+	NumberOfPages int      `json:"number_of_pages"`
+}
+
+// parseOpenLibraryJSON reads Open Library records. It accepts a JSON array of
+// records, one record per line, or the tab separated dump files where the
+// record is the last column.
+func parseOpenLibraryJSON(r io.Reader) ([]*importedBook, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []*openLibraryRecord{}
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &records); err != nil {
+			return nil, fmt.Errorf("could not parse Open Library JSON: %+v", err)
+		}
+	} else {
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+		for line := 1; scanner.Scan(); line++ {
+			text := scanner.Bytes()
+			if i := bytes.LastIndexByte(text, '\t'); i >= 0 {
+				text = text[i+1:]
+			}
+
+			if len(bytes.TrimSpace(text)) == 0 {
+				continue
+			}
+
+			rec := &openLibraryRecord{}
+			if err := json.Unmarshal(text, rec); err != nil {
+				return nil, fmt.Errorf("could not parse Open Library JSON on line %d: %+v", line, err)
+			}
+			records = append(records, rec)
+		}
+
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	books := make([]*importedBook, 0, len(records))
+	for _, rec := range records {
+		if rec.Title == "" {
+			continue
+		}
+
+		b := &Book{Title: rec.Title, Pages: rec.NumberOfPages, OpenLibraryID: rec.Key}
+		if rec.Subtitle != "" {
+			b.Title = fmt.Sprintf("%s: %s", rec.Title, rec.Subtitle)
+		}
+
+		b.Authors = append(b.Authors, rec.AuthorName...)
+		for _, a := range rec.Authors {
+			if a.Name != "" {
+				b.Authors = append(b.Authors, a.Name)
+			}
+		}
+		if len(b.Authors) == 0 && rec.ByStatement != "" {
+			b.Authors = []string{strings.TrimSuffix(rec.ByStatement, ".")}
+		}
+
+		if ids := append(rec.Identifiers.Goodreads, rec.IDGoodreads...); len(ids) > 0 {
+			b.GoodreadsID = ids[0]
+		}
+
+		isbns := []string{}
+		for _, list := range [][]string{rec.ISBN13, rec.ISBN10, rec.ISBN} {
+			for _, isbn := range list {
+				if isbn = cleanISBN(isbn); isbn != "" {
+					isbns = append(isbns, isbn)
+				}
+			}
+		}
+		if len(isbns) > 0 {
+			b.ISBN = isbns[0]
+		}
+
+		books = append(books, &importedBook{book: b, isbns: isbns})
+	}
+
+	return books, nil
+}
+
+// cleanISBN strips the spreadsheet quoting and dashes that exports put around
+// ISBNs.
+func cleanISBN(isbn string) string {
+	isbn = strings.TrimPrefix(strings.TrimSpace(isbn), "=")
+	isbn = strings.Trim(isbn, `"`)
+	isbn = strings.Replace(isbn, "-", "", -1)
+	return strings.TrimSpace(isbn)
+}
@@ -0,0 +1,38 @@
+package graphql
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseOpenLibraryJSON(t *testing.T) {
+	dump := "/type/edition\t/books/OL1M\t3\t2010-01-01\t" + `{"key": "/books/OL1M", "title": "Dune", "isbn_13": ["978-0-441-17271-9"], "identifiers": {"goodreads": ["234225"]}}` + "\n" +
+		"/type/edition\t/books/OL2M\t1\t2010-01-01\t" + `{"key": "/books/OL2M", "title": "Untitled Zine", "by_statement": "Someone."}` + "\n"
+
+	books, err := parseOpenLibraryJSON(strings.NewReader(dump))
+	if err != nil {
+		t.Fatalf("parseOpenLibraryJSON: %+v", err)
+	}
+
+	if len(books) != 2 {
+		t.Fatalf("got %d books, want 2", len(books))
+	}
+
+	dune := books[0].book
+	if dune.OpenLibraryID != "/books/OL1M" || dune.GoodreadsID != "234225" || dune.ISBN != "9780441172719" {
+		t.Errorf("got %+v", dune)
+	}
+
+	if got := dune.URI(); got.String() != "https://www.goodreads.com/book/show/234225" {
+		t.Errorf("URI() = %q", got.String())
+	}
+
+	zine := books[1].book
+	if zine.OpenLibraryID != "/books/OL2M" || len(zine.Authors) != 1 || zine.Authors[0] != "Someone" {
+		t.Errorf("got %+v", zine)
+	}
+
+	if got := zine.URI(); got.String() != "https://openlibrary.org/books/OL2M" {
+		t.Errorf("URI() = %q, want a link to Open Library", got.String())
+	}
+}
@@ -0,0 +1,243 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/icco/cacophony/models"
+)
+
+const (
+	// DefaultCacophonyURL is the cacophony server used if CACOPHONY_URL is not
+	// set.
+	DefaultCacophonyURL = "https://cacophony.natwelch.com"
+
+	// DefaultCacophonyTimeout is the timeout used if CACOPHONY_TIMEOUT is not
+	// set.
+	DefaultCacophonyTimeout = 10 * time.Second
+
+	// DefaultCacophonyRetries is the number of retries used if
+	// CACOPHONY_RETRIES is not set.
+	DefaultCacophonyRetries = 2
+
+	// DefaultCacophonyCacheTTL is the cache TTL used if CACOPHONY_CACHE_TTL is
+	// not set.
+	DefaultCacophonyCacheTTL = 5 * time.Minute
+)
+
+// CacophonyClient fetches URLs seen on the twitter home timeline from a
+// cacophony server (https://github.com/icco/cacophony).
+type CacophonyClient struct {
+	// BaseURL is the root of the cacophony server, without a trailing slash.
+	BaseURL string
+
+	// Retries is how many more times a request is attempted after a network
+	// error or a 5xx response.
+	Retries int
+
+	// CacheTTL is how long responses are reused for. Zero disables caching.
+	CacheTTL time.Duration
+
+	// HTTPClient is the client used for all requests. Its Timeout bounds each
+	// attempt.
+	HTTPClient *http.Client
+
+	mu    sync.Mutex
+	cache map[string]cacophonyCacheEntry
+}
+
+type cacophonyCacheEntry struct {
+	urls    []*models.SavedURL
+	expires time.Time
+}
+
+// NewCacophonyClient returns a client for the cacophony server at baseURL.
+func NewCacophonyClient(baseURL string, timeout time.Duration, retries int, cacheTTL time.Duration) *CacophonyClient {
+	return &CacophonyClient{
+		BaseURL:    baseURL,
+		Retries:    retries,
+		CacheTTL:   cacheTTL,
+		HTTPClient: &http.Client{Timeout: timeout},
+		cache:      map[string]cacophonyCacheEntry{},
+	}
+}
+
+// DefaultCacophonyClient returns a client configured from the environment.
+// CACOPHONY_URL sets the server, CACOPHONY_TIMEOUT and CACOPHONY_CACHE_TTL
+// take durations like "10s", and CACOPHONY_RETRIES takes a number. Unset or
+// invalid values fall back to the defaults above.
+func DefaultCacophonyClient() *CacophonyClient {
+	url := os.Getenv("CACOPHONY_URL")
+	if url == "" {
+		url = DefaultCacophonyURL
+	}
+
+	timeout := envDuration("CACOPHONY_TIMEOUT", DefaultCacophonyTimeout)
+	cacheTTL := envDuration("CACOPHONY_CACHE_TTL", DefaultCacophonyCacheTTL)
+
+	retries := DefaultCacophonyRetries
+	if v := os.Getenv("CACOPHONY_RETRIES"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			retries = n
+		} else {
+			log.WithField("CACOPHONY_RETRIES", v).Warn("invalid retries, using the default")
+		}
+	}
+
+	return NewCacophonyClient(url, timeout, retries, cacheTTL)
+}
+
+// envDuration parses the duration in the environment variable name, returning
+// def if it is unset or invalid.
+func envDuration(name string, def time.Duration) time.Duration {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		log.WithField(name, v).Warn("invalid duration, using the default")
+		return def
+	}
+
+	return d
+}
+
+// SavedURLs returns the most recently seen URLs, using limit and offset.
+func (c *CacophonyClient) SavedURLs(ctx context.Context, limit, offset int) ([]*models.SavedURL, error) {
+	url := fmt.Sprintf("%s/?count=%d&offset=%d", c.BaseURL, limit, offset)
+
+	if urls, ok := c.cached(url); ok {
+		return urls, nil
+	}
+
+	var err error
+	var urls []*models.SavedURL
+	for attempt := 0; attempt <= c.Retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(time.Duration(attempt) * 500 * time.Millisecond):
+			}
+		}
+
+		var retry bool
+		urls, retry, err = c.get(ctx, url)
+		if err == nil || !retry {
+			break
+		}
+
+		log.WithError(err).WithField("url", url).Warn("cacophony request failed")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.store(url, urls)
+
+	return urls, nil
+}
+
+// get does a single request. The bool returned is true if the request failed
+// in a way that is worth retrying.
+func (c *CacophonyClient) get(ctx context.Context, url string) ([]*models.SavedURL, bool, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	res, err := c.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, ctx.Err() == nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		// Drain the body so the connection can be reused.
+		io.Copy(ioutil.Discard, res.Body)
+		return nil, res.StatusCode >= 500, fmt.Errorf("cacophony returned %s", res.Status)
+	}
+
+	urls := []*models.SavedURL{}
+	if err := json.NewDecoder(res.Body).Decode(&urls); err != nil {
+		return nil, false, err
+	}
+
+	// A null in the response decodes to a nil entry, which callers do not
+	// expect.
+	saved := urls[:0]
+	for _, u := range urls {
+		if u != nil {
+			saved = append(saved, u)
+		}
+	}
+
+	return saved, false, nil
+}
+
+func (c *CacophonyClient) cached(url string) ([]*models.SavedURL, bool) {
+	if c.CacheTTL <= 0 {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.cache[url]
+	if !ok || time.Now().After(e.expires) {
+		return nil, false
+	}
+
+	return copySavedURLs(e.urls), true
+}
+
+func (c *CacophonyClient) store(url string, urls []*models.SavedURL) {
+	if c.CacheTTL <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cache == nil {
+		c.cache = map[string]cacophonyCacheEntry{}
+	}
+
+	now := time.Now()
+	for k, e := range c.cache {
+		if now.After(e.expires) {
+			delete(c.cache, k)
+		}
+	}
+
+	c.cache[url] = cacophonyCacheEntry{urls: copySavedURLs(urls), expires: now.Add(c.CacheTTL)}
+}
+
+// copySavedURLs copies urls, so callers can change what they are given
+// without changing the cache. Nil entries, which a null in the response
+// decodes to, are dropped.
+func copySavedURLs(urls []*models.SavedURL) []*models.SavedURL {
+	out := make([]*models.SavedURL, 0, len(urls))
+	for _, u := range urls {
+		if u == nil {
+			continue
+		}
+
+		c := *u
+		c.TweetIDs = append([]string(nil), u.TweetIDs...)
+		out = append(out, &c)
+	}
+
+	return out
+}
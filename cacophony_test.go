@@ -0,0 +1,225 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/icco/cacophony/models"
+)
+
+// cacophonyServer returns a test server that responds with handler, and a
+// counter of the requests it has received. The caller must close it.
+func cacophonyServer(t *testing.T, handler func(n int32, w http.ResponseWriter, r *http.Request)) (*httptest.Server, *int32) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler(atomic.AddInt32(&hits, 1), w, r)
+	}))
+
+	return srv, &hits
+}
+
+func writeSavedURLs(t *testing.T, w http.ResponseWriter, links ...string) {
+	urls := []*models.SavedURL{}
+	for _, l := range links {
+		urls = append(urls, &models.SavedURL{Link: l, TweetIDs: []string{"1"}})
+	}
+
+	if err := json.NewEncoder(w).Encode(urls); err != nil {
+		t.Errorf("could not encode response: %+v", err)
+	}
+}
+
+func TestCacophonySavedURLs(t *testing.T) {
+	srv, hits := cacophonyServer(t, func(n int32, w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("count"); got != "5" {
+			t.Errorf("count = %q, want 5", got)
+		}
+
+		if got := r.URL.Query().Get("offset"); got != "10" {
+			t.Errorf("offset = %q, want 10", got)
+		}
+
+		writeSavedURLs(t, w, "https://example.com", "https://example.org")
+	})
+	defer srv.Close()
+
+	c := NewCacophonyClient(srv.URL, time.Second, 0, 0)
+	urls, err := c.SavedURLs(context.Background(), 5, 10)
+	if err != nil {
+		t.Fatalf("SavedURLs: %+v", err)
+	}
+
+	if len(urls) != 2 || urls[0].Link != "https://example.com" {
+		t.Errorf("got %+v", urls)
+	}
+
+	if n := atomic.LoadInt32(hits); n != 1 {
+		t.Errorf("got %d requests, want 1", n)
+	}
+}
+
+func TestCacophonyRetry(t *testing.T) {
+	srv, hits := cacophonyServer(t, func(n int32, w http.ResponseWriter, r *http.Request) {
+		if n == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		writeSavedURLs(t, w, "https://example.com")
+	})
+	defer srv.Close()
+
+	c := NewCacophonyClient(srv.URL, time.Second, 1, 0)
+	urls, err := c.SavedURLs(context.Background(), 10, 0)
+	if err != nil {
+		t.Fatalf("SavedURLs: %+v", err)
+	}
+
+	if len(urls) != 1 {
+		t.Errorf("got %d urls, want 1", len(urls))
+	}
+
+	if n := atomic.LoadInt32(hits); n != 2 {
+		t.Errorf("got %d requests, want 2", n)
+	}
+}
+
+func TestCacophonyNoRetryOnClientError(t *testing.T) {
+	srv, hits := cacophonyServer(t, func(n int32, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer srv.Close()
+
+	c := NewCacophonyClient(srv.URL, time.Second, 2, 0)
+	if _, err := c.SavedURLs(context.Background(), 10, 0); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if n := atomic.LoadInt32(hits); n != 1 {
+		t.Errorf("got %d requests, want 1", n)
+	}
+}
+
+func TestCacophonyTimeout(t *testing.T) {
+	srv, _ := cacophonyServer(t, func(n int32, w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+	defer srv.Close()
+
+	c := NewCacophonyClient(srv.URL, 50*time.Millisecond, 0, 0)
+
+	start := time.Now()
+	if _, err := c.SavedURLs(context.Background(), 10, 0); err == nil {
+		t.Fatal("expected a timeout")
+	}
+
+	if d := time.Since(start); d > 500*time.Millisecond {
+		t.Errorf("request took %s, want it to time out after 50ms", d)
+	}
+}
+
+func TestCacophonyCache(t *testing.T) {
+	srv, hits := cacophonyServer(t, func(n int32, w http.ResponseWriter, r *http.Request) {
+		writeSavedURLs(t, w, "https://example.com")
+	})
+	defer srv.Close()
+
+	c := NewCacophonyClient(srv.URL, time.Second, 0, time.Minute)
+	urls, err := c.SavedURLs(context.Background(), 10, 0)
+	if err != nil {
+		t.Fatalf("SavedURLs: %+v", err)
+	}
+
+	// Changing what is returned must not change the cache.
+	urls[0].Link = "changed"
+	urls[0].TweetIDs[0] = "changed"
+
+	urls, err = c.SavedURLs(context.Background(), 10, 0)
+	if err != nil {
+		t.Fatalf("SavedURLs: %+v", err)
+	}
+
+	if n := atomic.LoadInt32(hits); n != 1 {
+		t.Errorf("got %d requests, want 1", n)
+	}
+
+	if urls[0].Link != "https://example.com" || urls[0].TweetIDs[0] != "1" {
+		t.Errorf("cached urls were changed: %+v", urls[0])
+	}
+
+	if _, err := c.SavedURLs(context.Background(), 20, 0); err != nil {
+		t.Fatalf("SavedURLs: %+v", err)
+	}
+
+	if n := atomic.LoadInt32(hits); n != 2 {
+		t.Errorf("got %d requests, want a new request for a different page", n)
+	}
+}
+
+func TestDefaultCacophonyClient(t *testing.T) {
+	for k, v := range map[string]string{
+		"CACOPHONY_URL":       "http://cacophony.test",
+		"CACOPHONY_TIMEOUT":   "3s",
+		"CACOPHONY_RETRIES":   "5",
+		"CACOPHONY_CACHE_TTL": "1m",
+	} {
+		old, ok := os.LookupEnv(k)
+		os.Setenv(k, v)
+		defer func(k, old string, ok bool) {
+			if ok {
+				os.Setenv(k, old)
+			} else {
+				os.Unsetenv(k)
+			}
+		}(k, old, ok)
+	}
+
+	c := DefaultCacophonyClient()
+	if c.BaseURL != "http://cacophony.test" {
+		t.Errorf("BaseURL = %q", c.BaseURL)
+	}
+
+	if c.HTTPClient.Timeout != 3*time.Second {
+		t.Errorf("Timeout = %s", c.HTTPClient.Timeout)
+	}
+
+	if c.Retries != 5 {
+		t.Errorf("Retries = %d", c.Retries)
+	}
+
+	if c.CacheTTL != time.Minute {
+		t.Errorf("CacheTTL = %s", c.CacheTTL)
+	}
+}
+
+func TestCacophonySkipsNullURLs(t *testing.T) {
+	srv, _ := cacophonyServer(t, func(n int32, w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[null, {"link": "https://example.com", "tweet_ids": ["1"]}, null]`))
+	})
+	defer srv.Close()
+
+	c := NewCacophonyClient(srv.URL, time.Second, 0, time.Minute)
+	for i := 0; i < 2; i++ {
+		urls, err := c.SavedURLs(context.Background(), 10, 0)
+		if err != nil {
+			t.Fatalf("SavedURLs: %+v", err)
+		}
+
+		if len(urls) != 1 || urls[0] == nil || urls[0].Link != "https://example.com" {
+			t.Errorf("request %d: got %+v", i, urls)
+		}
+	}
+
+	if got := copySavedURLs([]*models.SavedURL{nil, {Link: "a"}}); len(got) != 1 || got[0].Link != "a" {
+		t.Errorf("copySavedURLs = %+v", got)
+	}
+}
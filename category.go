@@ -0,0 +1,107 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// likeEscaper escapes the characters that are special in a LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// CleanCategory normalizes a category path, so that " recipes//baking/ "
+// becomes "recipes/baking".
+func CleanCategory(path string) string {
+	parts := []string{}
+	for _, p := range strings.Split(path, "/") {
+		if p = strings.TrimSpace(p); p != "" {
+			parts = append(parts, p)
+		}
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// GetCategories returns the tree of categories that pages the current user can
+// see are in. Every category that is a parent of a page's category is
+// included, even if no page is directly in it.
+func GetCategories(ctx context.Context) ([]*Category, error) {
+	args := []interface{}{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	counts, err := queryCounts(ctx, "SELECT category, COUNT(*) FROM pages WHERE category <> '' AND "+pagesVisibleTo(ctx, arg)+" GROUP BY category", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &Category{}
+	byPath := map[string]*Category{"": root}
+	for _, c := range counts {
+		parent := root
+		parts := strings.Split(c.Key, "/")
+		for i, name := range parts {
+			path := strings.Join(parts[:i+1], "/")
+			cat, ok := byPath[path]
+			if !ok {
+				cat = &Category{Path: path, Name: name, Children: []*Category{}}
+				byPath[path] = cat
+				parent.Children = append(parent.Children, cat)
+			}
+			cat.Total += c.Count
+			parent = cat
+		}
+		parent.Count += c.Count
+	}
+
+	sortCategories(root.Children)
+
+	return root.Children, nil
+}
+
+// sortCategories sorts a tree of categories by name.
+func sortCategories(cats []*Category) {
+	sort.Slice(cats, func(i, j int) bool { return cats[i].Name < cats[j].Name })
+	for _, c := range cats {
+		sortCategories(c.Children)
+	}
+}
+
+// PagesByCategory returns the pages in a category, ordered by title. If
+// recursive is true, pages in categories beneath it are included too.
+func PagesByCategory(ctx context.Context, path string, recursive bool) ([]*Page, error) {
+	path = CleanCategory(path)
+
+	if recursive && path == "" {
+		return queryPages(ctx, "SELECT "+pageColumns+" FROM pages ORDER BY title ASC")
+	}
+
+	if recursive {
+		return queryPages(ctx, "SELECT "+pageColumns+" FROM pages WHERE category = $1 OR category LIKE $2 ORDER BY title ASC", path, likeEscaper.Replace(path)+"/%")
+	}
+
+	return queryPages(ctx, "SELECT "+pageColumns+" FROM pages WHERE category = $1 ORDER BY title ASC", path)
+}
+
+// Breadcrumbs returns each category this page is in, from the top of the
+// tree down.
+func (p *Page) Breadcrumbs() []*Breadcrumb {
+	crumbs := []*Breadcrumb{}
+	path := CleanCategory(p.Category)
+	if path == "" {
+		return crumbs
+	}
+
+	parts := strings.Split(path, "/")
+	for i, name := range parts {
+		crumbs = append(crumbs, &Breadcrumb{
+			Name: name,
+			Path: strings.Join(parts[:i+1], "/"),
+		})
+	}
+
+	return crumbs
+}
@@ -0,0 +1,73 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCleanCategory(t *testing.T) {
+	for in, want := range map[string]string{
+		"":                   "",
+		" recipes//baking/ ": "recipes/baking",
+		"/a / b /":           "a/b",
+		"a/ / /b":            "a/b",
+		"\tnotes\n":          "notes",
+		"already/clean/path": "already/clean/path",
+	} {
+		if got := CleanCategory(in); got != want {
+			t.Errorf("CleanCategory(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+// TestCategoryMigration checks that the migration adding category paths
+// normalizes categories the same way CleanCategory does.
+func TestCategoryMigration(t *testing.T) {
+	testDB(t)
+
+	script := testMigration(t, "Add page category paths")
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx: %+v", err)
+	}
+	defer tx.Rollback()
+
+	in := []string{" recipes//baking/ ", "/a / b /", "a/ / /b", "\tnotes\n"}
+	if _, err := tx.ExecContext(ctx, "CREATE TEMPORARY TABLE pages (category text) ON COMMIT DROP"); err != nil {
+		t.Fatalf("could not create table: %+v", err)
+	}
+
+	for _, c := range in {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO pages(category) VALUES ($1)", c); err != nil {
+			t.Fatalf("could not insert: %+v", err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		t.Fatalf("could not run migration: %+v", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, "SELECT category FROM pages")
+	if err != nil {
+		t.Fatalf("could not query: %+v", err)
+	}
+	defer rows.Close()
+
+	want := map[string]bool{}
+	for _, c := range in {
+		want[CleanCategory(c)] = true
+	}
+
+	for rows.Next() {
+		var got string
+		if err := rows.Scan(&got); err != nil {
+			t.Fatalf("could not scan: %+v", err)
+		}
+
+		if !want[got] {
+			t.Errorf("migration produced %q", got)
+		}
+	}
+}
@@ -207,6 +207,295 @@ var (
 			Description: "Add second trgm index",
 			Script: `
       CREATE INDEX title_gin_idx ON posts USING GIN(title gin_trgm_ops);
+      `,
+		},
+		{
+			Version:     16,
+			Description: "Add links search indexes",
+			Script: `
+      CREATE INDEX links_tags_gin_idx ON links USING GIN(tags);
+      CREATE INDEX links_title_gin_idx ON links USING GIN(title gin_trgm_ops);
+      CREATE INDEX links_description_gin_idx ON links USING GIN(description gin_trgm_ops);
+      CREATE INDEX links_host_idx ON links(lower(substring(uri from '^[A-Za-z]+://(?:www\.)?([^/:?#]+)')));
+      CREATE INDEX links_host_gin_idx ON links USING GIN(lower(substring(uri from '^[A-Za-z]+://(?:www\.)?([^/:?#]+)')) gin_trgm_ops);
+      CREATE INDEX links_created_idx ON links(created);
+      `,
+		},
+		{
+			Version:     17,
+			Description: "Add tweet reply relationships",
+			Script: `
+      ALTER TABLE tweets ADD COLUMN in_reply_to_status_id text;
+      ALTER TABLE tweets ADD COLUMN in_reply_to_screen_name text;
+      ALTER TABLE tweets ADD COLUMN quoted_status_id text;
+      ALTER TABLE tweets ADD COLUMN conversation_id text;
+      CREATE INDEX tweets_in_reply_to_status_id_idx ON tweets(in_reply_to_status_id);
+      CREATE INDEX tweets_conversation_id_idx ON tweets(conversation_id);
+      `,
+		},
+		{
+			Version:     18,
+			Description: "Add tweets search indexes",
+			Script: `
+      CREATE INDEX tweets_text_gin_idx ON tweets USING GIN(text gin_trgm_ops);
+      CREATE INDEX tweets_screen_name_posted_idx ON tweets(screen_name, posted);
+      `,
+		},
+		{
+			Version:     19,
+			Description: "Add reading shelves to books",
+			Script: `
+      ALTER TABLE books ADD COLUMN authors text[];
+      ALTER TABLE books ADD COLUMN isbn text;
+      ALTER TABLE books ADD COLUMN open_library_id text;
+      ALTER TABLE books ADD COLUMN shelf text NOT NULL DEFAULT 'to_read';
+      ALTER TABLE books ADD COLUMN started timestamp with time zone;
+      ALTER TABLE books ADD COLUMN finished timestamp with time zone;
+      ALTER TABLE books ADD COLUMN rating int;
+      ALTER TABLE books ADD COLUMN review text;
+      CREATE INDEX books_shelf_idx ON books(shelf);
+      CREATE UNIQUE INDEX books_open_library_id_idx ON books(open_library_id);
+      `,
+		},
+		{
+			Version:     20,
+			Description: "Add book pages and reading goals",
+			Script: `
+      ALTER TABLE books ADD COLUMN pages int;
+      CREATE INDEX books_finished_idx ON books(finished);
+      CREATE TABLE reading_goals (
+        year int PRIMARY KEY NOT NULL,
+        goal int NOT NULL,
+        created_at timestamp with time zone,
+        modified_at timestamp with time zone
+      );
+      `,
+		},
+		{
+			Version:     21,
+			Description: "Add duration to logs",
+			Script: `
+      ALTER TABLE logs ADD COLUMN duration double precision;
+      `,
+		},
+		{
+			Version:     22,
+			Description: "Add logs search indexes",
+			Script: `
+      CREATE INDEX logs_user_id_datetime_idx ON logs(user_id, datetime);
+      CREATE INDEX logs_description_gin_idx ON logs USING GIN(description gin_trgm_ops);
+      `,
+		},
+		{
+			Version:     23,
+			Description: "Add logs location indexes",
+			Script: `
+      CREATE INDEX logs_location_gist_idx ON logs USING GIST(location);
+      CREATE INDEX logs_location_geom_gist_idx ON logs USING GIST((location::geometry));
+      `,
+		},
+		{
+			Version:     24,
+			Description: "Add log visibility",
+			Script: `
+      ALTER TABLE logs ADD COLUMN visibility text;
+      CREATE TABLE log_projects (
+        user_id text NOT NULL,
+        project text NOT NULL,
+        visibility text NOT NULL,
+        created_at timestamp with time zone,
+        modified_at timestamp with time zone,
+        PRIMARY KEY(user_id, project)
+      );
+      `,
+		},
+		{
+			Version:     25,
+			Description: "Add feed tokens to users",
+			Script: `
+      ALTER TABLE users ADD COLUMN feed_token UUID DEFAULT gen_random_uuid();
+      CREATE UNIQUE INDEX users_feed_token_idx ON users(feed_token);
+      `,
+		},
+		{
+			Version:     26,
+			Description: "Add page revisions",
+			Script: `
+      CREATE TABLE page_revisions (
+        id text PRIMARY KEY,
+        page_id text NOT NULL,
+        slug text,
+        title text,
+        content text,
+        category text,
+        user_id text,
+        created_at timestamp with time zone
+      );
+      CREATE INDEX page_revisions_page_id_created_at_idx ON page_revisions(page_id, created_at);
+      INSERT INTO page_revisions(id, page_id, slug, title, content, category, user_id, created_at)
+        SELECT gen_random_uuid()::text, id, slug, title, content, category, user_id, modified_at FROM pages;
+      `,
+		},
+		{
+			Version:     27,
+			Description: "Add page links",
+			Script: `
+      CREATE TABLE page_links (
+        page_id text NOT NULL,
+        target_slug text NOT NULL,
+        PRIMARY KEY(page_id, target_slug)
+      );
+      CREATE INDEX page_links_target_slug_idx ON page_links(target_slug);
+      `,
+		},
+		{
+			Version:     28,
+			Description: "Add unique slugs and slug redirects",
+			Script: `
+      ALTER TABLE posts ADD COLUMN slug text;
+      DO $$
+      DECLARE
+        t text;
+        r record;
+        base text;
+        candidate text;
+        taken boolean;
+        i int;
+      BEGIN
+        FOREACH t IN ARRAY ARRAY['pages', 'posts'] LOOP
+          FOR r IN EXECUTE format(
+            'SELECT a.id::text AS id, a.slug, a.title FROM %1$I AS a
+             WHERE a.slug IS NULL OR a.slug = '''' OR EXISTS (
+               SELECT 1 FROM %1$I AS b
+               WHERE b.slug = a.slug AND (b.created_at, b.id::text) < (a.created_at, a.id::text))
+             ORDER BY a.created_at, a.id', t) LOOP
+            base := NULLIF(r.slug, '');
+            IF base IS NULL THEN
+              base := trim(both '-' from regexp_replace(lower(COALESCE(r.title, '')), '[^a-z0-9]+', '-', 'g'));
+              IF base = '' THEN
+                base := rtrim(t, 's');
+              ELSIF t = 'posts' AND base ~ '^[0-9]+$' THEN
+                base := 'post-' || base;
+              END IF;
+            END IF;
+
+            candidate := base;
+            i := 2;
+            LOOP
+              EXECUTE format('SELECT EXISTS(SELECT 1 FROM %I WHERE slug = $1 AND id::text <> $2)', t) INTO taken USING candidate, r.id;
+              EXIT WHEN NOT taken;
+              candidate := base || '-' || i;
+              i := i + 1;
+            END LOOP;
+
+            EXECUTE format('UPDATE %I SET slug = $1 WHERE id::text = $2', t) USING candidate, r.id;
+          END LOOP;
+        END LOOP;
+      END
+      $$;
+      CREATE UNIQUE INDEX pages_slug_idx ON pages(slug);
+      CREATE UNIQUE INDEX posts_slug_idx ON posts(slug);
+      CREATE TABLE slug_redirects (
+        kind text NOT NULL,
+        slug text NOT NULL,
+        target_id text NOT NULL,
+        created_at timestamp with time zone,
+        PRIMARY KEY(kind, slug)
+      );
+      `,
+		},
+		{
+			Version:     29,
+			Description: "Add page category paths",
+			Script: `
+      UPDATE pages SET category = trim(both '/' from
+        regexp_replace(regexp_replace(regexp_replace(COALESCE(category, ''), '^\s+|\s+$', '', 'g'), '\s*/\s*', '/', 'g'), '/{2,}', '/', 'g'));
+      CREATE INDEX pages_category_idx ON pages(category text_pattern_ops);
+      `,
+		},
+		// Pages only ever recorded who last edited them, so that is the best
+		// guess at who owns the pages that already exist.
+		{
+			Version:     30,
+			Description: "Add page permissions",
+			Script: `
+      ALTER TABLE pages ADD COLUMN owner_id text;
+      UPDATE pages SET owner_id = user_id;
+      ALTER TABLE pages ADD COLUMN visibility text NOT NULL DEFAULT 'public';
+      ALTER TABLE pages ADD COLUMN edit_role text;
+      ALTER TABLE pages ADD COLUMN editors text[] NOT NULL DEFAULT '{}';
+      `,
+		},
+		{
+			Version:     31,
+			Description: "Add photo dimensions",
+			Script: `
+      ALTER TABLE photos ADD COLUMN width int;
+      ALTER TABLE photos ADD COLUMN height int;
+      CREATE INDEX photos_user_id_created_at_idx ON photos(user_id, created_at);
+      `,
+		},
+		{
+			Version:     32,
+			Description: "Add photo variants and exif",
+			Script: `
+      ALTER TABLE photos ADD COLUMN taken_at timestamp with time zone;
+      ALTER TABLE photos ADD COLUMN camera_make text;
+      ALTER TABLE photos ADD COLUMN camera_model text;
+      ALTER TABLE photos ADD COLUMN location geography(POINT,4326);
+      CREATE TABLE photo_variants (
+        photo_id text NOT NULL,
+        name text NOT NULL,
+        content_type text NOT NULL,
+        width int NOT NULL,
+        height int NOT NULL,
+        path text NOT NULL,
+        PRIMARY KEY(photo_id, name)
+      );
+      `,
+		},
+		{
+			Version:     33,
+			Description: "Add photo checksums",
+			Script: `
+      ALTER TABLE photos ADD COLUMN size bigint;
+      ALTER TABLE photos ADD COLUMN crc32c bigint;
+      ALTER TABLE photos ADD COLUMN upload_hash text;
+      CREATE UNIQUE INDEX photos_user_id_upload_hash_idx ON photos(user_id, upload_hash);
+      `,
+		},
+		{
+			Version:     34,
+			Description: "Add photo captions and posts",
+			Script: `
+      ALTER TABLE photos ADD COLUMN caption text;
+      ALTER TABLE photos ADD COLUMN post_id bigint;
+      `,
+		},
+		{
+			Version:     35,
+			Description: "Add albums and photo attachments",
+			Script: `
+      CREATE TABLE albums (
+        id text PRIMARY KEY,
+        title text NOT NULL,
+        description text,
+        cover_photo_id text,
+        user_id text,
+        created_at timestamp with time zone NOT NULL,
+        modified_at timestamp with time zone NOT NULL
+      );
+      CREATE TABLE photo_attachments (
+        kind text NOT NULL,
+        target_id text NOT NULL,
+        photo_id text NOT NULL,
+        position int NOT NULL,
+        PRIMARY KEY(kind, target_id, photo_id)
+      );
+      CREATE INDEX photo_attachments_photo_id_idx ON photo_attachments(photo_id);
+      INSERT INTO photo_attachments(kind, target_id, photo_id, position)
+      SELECT 'post', post_id::text, id, ROW_NUMBER() OVER (PARTITION BY post_id ORDER BY created_at)
+      FROM photos WHERE post_id IS NOT NULL;
       `,
 		},
 	}
@@ -235,5 +524,40 @@ func InitDB(dataSourceName string) (*sql.DB, error) {
 		return nil, err
 	}
 
+	if err = backfillPageLinks(context.Background()); err != nil {
+		return nil, fmt.Errorf("Failed to backfill page links: %v", err)
+	}
+
 	return db, err
 }
+
+// execer runs statements on a *sql.DB or in a *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// queryCounts runs a query that selects a key and a count, and returns them
+// as Counts.
+func queryCounts(ctx context.Context, query string, args ...interface{}) ([]*Count, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make([]*Count, 0)
+	for rows.Next() {
+		c := new(Count)
+		err := rows.Scan(&c.Key, &c.Count)
+		if err != nil {
+			return nil, err
+		}
+		counts = append(counts, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
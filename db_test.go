@@ -0,0 +1,67 @@
+package graphql
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	testDBOnce sync.Once
+	testDBErr  error
+)
+
+// testDB connects to and migrates the database in TEST_DATABASE_URL, or skips
+// the test if it is not set. Tests share the database, so they must not depend
+// on it being empty.
+func testDB(t *testing.T) {
+	t.Helper()
+
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	testDBOnce.Do(func() {
+		_, testDBErr = InitDB(url)
+	})
+
+	if testDBErr != nil {
+		t.Fatalf("could not set up database: %+v", testDBErr)
+	}
+}
+
+// testUser saves a new user with role.
+func testUser(t *testing.T, role Role) *User {
+	t.Helper()
+
+	u := &User{
+		ID:      "test-" + uuid.New().String(),
+		Role:    string(role),
+		Created: time.Now(),
+	}
+
+	if err := u.Save(context.Background()); err != nil {
+		t.Fatalf("could not save user: %+v", err)
+	}
+
+	return u
+}
+
+// testMigration returns the script of the migration with description.
+func testMigration(t *testing.T, description string) string {
+	t.Helper()
+
+	for _, m := range migrations {
+		if m.Description == description {
+			return m.Script
+		}
+	}
+
+	t.Fatalf("no migration %q", description)
+	return ""
+}
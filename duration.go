@@ -35,6 +35,11 @@ func ParseDurationFromString(dur string) Duration {
 	return NewDuration(i.Seconds())
 }
 
+// Seconds returns the duration as a floating point number of seconds.
+func (d Duration) Seconds() float64 {
+	return d.raw
+}
+
 // float64 returns the value
 func (d *Duration) float64() float64 {
 	return d.raw
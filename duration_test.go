@@ -0,0 +1,59 @@
+package graphql
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+)
+
+func TestDurationDatabaseRoundTrip(t *testing.T) {
+	for _, want := range []float64{0, 1.5, 3600, 86400.25} {
+		v, err := NewDuration(want).Value()
+		if err != nil {
+			t.Fatalf("Value: %+v", err)
+		}
+
+		var d Duration
+		if err := d.Scan(v); err != nil {
+			t.Fatalf("Scan(%v): %+v", v, err)
+		}
+
+		if d.Seconds() != want {
+			t.Errorf("got %f, want %f", d.Seconds(), want)
+		}
+	}
+}
+
+func TestDurationNilIsNull(t *testing.T) {
+	var d *Duration
+	v, err := driver.DefaultParameterConverter.ConvertValue(d)
+	if err != nil {
+		t.Fatalf("ConvertValue: %+v", err)
+	}
+
+	if v != nil {
+		t.Errorf("a nil duration was stored as %v, want NULL", v)
+	}
+}
+
+func TestDurationJSONRoundTrip(t *testing.T) {
+	b, err := json.Marshal(NewDuration(90.5))
+	if err != nil {
+		t.Fatalf("Marshal: %+v", err)
+	}
+
+	var d Duration
+	if err := json.Unmarshal(b, &d); err != nil {
+		t.Fatalf("Unmarshal(%s): %+v", b, err)
+	}
+
+	if d.Seconds() != 90.5 {
+		t.Errorf("got %f, want 90.5", d.Seconds())
+	}
+}
+
+func TestParseDurationFromString(t *testing.T) {
+	if got := ParseDurationFromString("1h30m").Seconds(); got != 5400 {
+		t.Errorf("got %f, want 5400", got)
+	}
+}
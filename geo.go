@@ -32,6 +32,26 @@ func GeoFromOrb(p *orb.Point) *Geo {
 	}
 }
 
+// GeoFromWKB creates a Geo from a point encoded as well-known binary, such as
+// the output of ST_AsBinary. It returns nil if b is empty.
+func GeoFromWKB(b []byte) (*Geo, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+
+	g, err := wkb.Unmarshal(b)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := g.(orb.Point)
+	if !ok {
+		return nil, fmt.Errorf("geometry is a %s, not a Point", g.GeoJSONType())
+	}
+
+	return GeoFromOrb(&p), nil
+}
+
 // GeoScanner is used for unmarshaling data from a database row.
 func GeoScanner(g interface{}) *wkb.GeometryScanner {
 	return wkb.Scanner(g)
@@ -48,5 +68,6 @@ func GeoConvertValue(v interface{}) (driver.Value, error) {
 		return driver.Value(nil), nil
 	}
 
-	return wkb.Value(g.ToOrb()), nil
+	// The encoder only accepts geometry values, not pointers to them.
+	return wkb.Value(*g.ToOrb()), nil
 }
@@ -0,0 +1,57 @@
+package graphql
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/encoding/wkb"
+)
+
+func TestGeoWKBRoundTrip(t *testing.T) {
+	want := &Geo{Lat: 37.7749, Long: -122.4194}
+
+	v, err := GeoConvertValue(want)
+	if err != nil {
+		t.Fatalf("GeoConvertValue: %+v", err)
+	}
+
+	b, err := v.(driver.Valuer).Value()
+	if err != nil {
+		t.Fatalf("Value: %+v", err)
+	}
+
+	got, err := GeoFromWKB(b.([]byte))
+	if err != nil {
+		t.Fatalf("GeoFromWKB: %+v", err)
+	}
+
+	if *got != *want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestGeoFromWKBEmpty(t *testing.T) {
+	got, err := GeoFromWKB(nil)
+	if err != nil || got != nil {
+		t.Errorf("got %+v, %+v, want nil, nil", got, err)
+	}
+}
+
+func TestGeoFromWKBNotAPoint(t *testing.T) {
+	b, err := wkb.Marshal(orb.LineString{{0, 0}, {1, 1}})
+	if err != nil {
+		t.Fatalf("Marshal: %+v", err)
+	}
+
+	if _, err := GeoFromWKB(b); err == nil {
+		t.Error("expected an error for a line")
+	}
+}
+
+func TestGeoConvertValueNil(t *testing.T) {
+	v, err := GeoConvertValue((*Geo)(nil))
+	if err != nil || v != nil {
+		t.Errorf("got %v, %+v, want nil, nil", v, err)
+	}
+}
@@ -9,6 +9,7 @@ require (
 	github.com/99designs/gqlgen-contrib v0.0.0-20190222015228-c654377d611c
 	github.com/GuiaBolso/darwin v0.0.0-20170210191649-86919dfcf808
 	github.com/auth0-community/go-auth0 v1.0.0
+	github.com/aws/aws-sdk-go v1.18.6
 	github.com/go-chi/chi v4.0.2+incompatible
 	github.com/go-chi/cors v1.0.0
 	github.com/google/uuid v1.1.1
@@ -20,10 +21,12 @@ require (
 	github.com/paulmach/orb v0.1.3
 	github.com/rainycape/unidecode v0.0.0-20150907023854-cb7f23ec59be // indirect
 	github.com/russross/blackfriday v2.0.0+incompatible
+	github.com/rwcarlsen/goexif v0.0.0-20190401172101-9e8deecbddbd
 	github.com/sirupsen/logrus v1.4.1
 	github.com/unrolled/render v1.0.0
 	github.com/unrolled/secure v1.0.0
 	github.com/vektah/gqlparser v1.1.2
 	go.opencensus.io v0.21.0
+	golang.org/x/image v0.0.0-20190802002840-cff245a6509b
 	gopkg.in/square/go-jose.v2 v2.3.1
 )
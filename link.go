@@ -4,11 +4,16 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/lib/pq"
 )
 
+// linkHost is the lowercased host of a link's uri, without a leading "www.".
+// It must match the expression the links_host indexes are built on.
+const linkHost = `lower(substring(uri from '^[A-Za-z]+://(?:www\.)?([^/:?#]+)'))`
+
 // Link is a link I have save on pinboard or a link in a post.
 type Link struct {
 	ID          string    `json:"id"`
@@ -70,6 +75,22 @@ func GetLinkByURI(ctx context.Context, uri string) (*Link, error) {
 	}
 }
 
+// getSavedLink is like GetLinkByURI, but returns nil instead of an error if
+// the link has not been saved.
+func getSavedLink(ctx context.Context, uri string) (*Link, error) {
+	var link Link
+	row := db.QueryRowContext(ctx, "SELECT id, title, uri, description, created, modified_at, tags FROM links WHERE uri = $1", uri)
+	err := row.Scan(&link.ID, &link.Title, &link.URI, &link.Description, &link.Created, &link.Modified, pq.Array(&link.Tags))
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("Error running get query: %+v", err)
+	default:
+		return &link, nil
+	}
+}
+
 // GetLinkByID gets a link by id from the database.
 func GetLinkByID(ctx context.Context, id string) (*Link, error) {
 	var link Link
@@ -114,3 +135,75 @@ func GetLinks(ctx context.Context, limit int, offset int) ([]*Link, error) {
 
 	return links, nil
 }
+
+// FilterLinks returns links from the database that match all of the set fields
+// in filter, in reverse chronological order.
+func FilterLinks(ctx context.Context, filter *LinkFilter, limit int, offset int) ([]*Link, error) {
+	if filter == nil {
+		return GetLinks(ctx, limit, offset)
+	}
+
+	where := []string{}
+	args := []interface{}{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.Tag != nil {
+		where = append(where, fmt.Sprintf("tags @> ARRAY[%s]::text[]", arg(*filter.Tag)))
+	}
+
+	if filter.Domain != nil {
+		domain := strings.ToLower(strings.TrimPrefix(*filter.Domain, "www."))
+		where = append(where, fmt.Sprintf("(%s = %s OR %s LIKE '%%.' || %s)", linkHost, arg(domain), linkHost, arg(likeEscaper.Replace(domain))))
+	}
+
+	if filter.Search != nil {
+		p := arg(likeEscaper.Replace(*filter.Search))
+		where = append(where, fmt.Sprintf("(title ILIKE '%%' || %s || '%%' OR description ILIKE '%%' || %s || '%%')", p, p))
+	}
+
+	if filter.From != nil {
+		where = append(where, fmt.Sprintf("created >= %s", arg(*filter.From)))
+	}
+
+	if filter.To != nil {
+		where = append(where, fmt.Sprintf("created < %s", arg(*filter.To)))
+	}
+
+	query := "SELECT id, title, uri, description, created, modified_at, tags FROM links"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += fmt.Sprintf(" ORDER BY created DESC LIMIT %s OFFSET %s", arg(limit), arg(offset))
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	links := make([]*Link, 0)
+	for rows.Next() {
+		link := new(Link)
+		err := rows.Scan(&link.ID, &link.Title, &link.URI, &link.Description, &link.Created, &link.Modified, pq.Array(&link.Tags))
+		if err != nil {
+			return nil, err
+		}
+
+		links = append(links, link)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+// LinkTags returns all tags used on links and how many links use each one,
+// most used first.
+func LinkTags(ctx context.Context) ([]*Count, error) {
+	return queryCounts(ctx, "SELECT UNNEST(tags) AS tag, COUNT(*) AS cnt FROM links GROUP BY tag ORDER BY cnt DESC, tag ASC")
+}
@@ -2,7 +2,9 @@ package graphql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,9 +19,19 @@ type Log struct {
 	Location    *Geo      `json:"location"`
 	Project     string    `json:"project"`
 	User        User      `json:"user"`
-	Duration    Duration  `json:"duration"`
-	Created     time.Time
-	Modified    time.Time
+	Duration    *Duration `json:"duration"`
+
+	// Visibility is set when the log overrides the visibility of its project.
+	Visibility          *Visibility `json:"visibility"`
+	EffectiveVisibility Visibility  `json:"effectiveVisibility"`
+
+	Created  time.Time
+	Modified time.Time
+
+	// distance is how many meters the log is from distanceFrom, when it was
+	// found by LogsNear.
+	distance     *float64
+	distanceFrom Geo
 }
 
 // Save inserts or updates a log into the database.
@@ -51,13 +63,17 @@ func (l *Log) Save(ctx context.Context) error {
 		return fmt.Errorf("no user specified")
 	}
 
+	if l.Visibility != nil && !l.Visibility.IsValid() {
+		return fmt.Errorf("%s is not a valid visibility", *l.Visibility)
+	}
+
 	if _, err := db.ExecContext(
 		ctx,
 		`
-INSERT INTO logs(id, code, datetime, description, location, project, user_id, created_at, modified_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+INSERT INTO logs(id, code, datetime, description, location, project, user_id, created_at, modified_at, duration, visibility)
+VALUES ($1, $2, $3, $4, ST_GeogFromWKB($5), $6, $7, $8, $9, $10, $11)
 ON CONFLICT (id) DO UPDATE
-SET (code, datetime, description, location, project, user_id, created_at, modified_at) = ($2, $3, $4, $5, $6, $7, $8, $9)
+SET (code, datetime, description, location, project, user_id, created_at, modified_at, duration, visibility) = ($2, $3, $4, ST_GeogFromWKB($5), $6, $7, $8, $9, $10, $11)
 WHERE logs.id = $1;
 `,
 		l.ID,
@@ -68,11 +84,31 @@ WHERE logs.id = $1;
 		l.Project,
 		l.User.ID,
 		l.Created,
-		l.Modified); err != nil {
+		l.Modified,
+		l.Duration,
+		l.Visibility); err != nil {
 		return err
 	}
 
-	return nil
+	row := db.QueryRowContext(ctx, "SELECT "+logVisibility+" FROM logs WHERE id = $1", l.ID)
+	return row.Scan(&l.EffectiveVisibility)
+}
+
+// Delete removes a log from the database.
+func (l *Log) Delete(ctx context.Context) error {
+	_, err := db.ExecContext(ctx, "DELETE FROM logs WHERE id = $1", l.ID)
+	return err
+}
+
+// CanEdit reports whether the current user can change or delete this log,
+// which only its owner and admins can do.
+func (l *Log) CanEdit(ctx context.Context) bool {
+	u := GetUserFromContext(ctx)
+	if u == nil {
+		return false
+	}
+
+	return u.ID == l.User.ID || Role(u.Role) == RoleAdmin
 }
 
 // SetUser looks up a user by ID and then sets it for this log.
@@ -89,16 +125,174 @@ func (l *Log) SetUser(ctx context.Context, id string) error {
 	return nil
 }
 
+// logVisibility is the visibility of a row in logs: its own if it has one,
+// otherwise its project's, otherwise private.
+const logVisibility = `COALESCE(logs.visibility, (
+  SELECT log_projects.visibility FROM log_projects
+  WHERE log_projects.user_id = logs.user_id AND log_projects.project = logs.project
+), 'private')`
+
+// logColumns are the columns selected for every log query, in the order
+// queryLogs expects them.
+const logColumns = "id, code, datetime, description, ST_AsBinary(location), project, user_id, created_at, modified_at, duration, visibility, " + logVisibility
+
+// logsVisibleTo returns a SQL condition that limits logs owned by owner to the
+// ones the current user can see. Owners and admins can see everything, other
+// users can see public and logged_in logs, and everyone else only public logs.
+func logsVisibleTo(ctx context.Context, owner *User) string {
+	u := GetUserFromContext(ctx)
+	switch {
+	case u != nil && (u.ID == owner.ID || Role(u.Role) == RoleAdmin):
+		return "TRUE"
+	case u != nil:
+		return fmt.Sprintf("%s IN ('%s', '%s')", logVisibility, VisibilityPublic, VisibilityLoggedIn)
+	default:
+		return fmt.Sprintf("%s = '%s'", logVisibility, VisibilityPublic)
+	}
+}
+
+// GetLog gets a log by ID from the database, if the current user can see it.
+func GetLog(ctx context.Context, id string) (*Log, error) {
+	logs, err := queryLogs(ctx, "SELECT "+logColumns+" FROM logs WHERE id = $1", id)
+	if err != nil {
+		return nil, fmt.Errorf("Error running get query: %+v", err)
+	}
+
+	if len(logs) == 0 {
+		return nil, fmt.Errorf("No log %s", id)
+	}
+
+	l := logs[0]
+	if !l.CanEdit(ctx) {
+		visible, err := l.visible(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if !visible {
+			return nil, fmt.Errorf("No log %s", id)
+		}
+	}
+
+	return l, nil
+}
+
+// visible checks if the current user can see this log.
+func (l *Log) visible(ctx context.Context) (bool, error) {
+	var visible bool
+	row := db.QueryRowContext(ctx, "SELECT "+logsVisibleTo(ctx, &l.User)+" FROM logs WHERE id = $1", l.ID)
+	err := row.Scan(&visible)
+	return visible, err
+}
+
+// SetLogProjectVisibility sets the visibility of the logs in one of a User's
+// projects. Logs that set their own visibility are not affected.
+func SetLogProjectVisibility(ctx context.Context, u *User, project string, v Visibility) error {
+	if u == nil {
+		return fmt.Errorf("no user specified")
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("%s is not a valid visibility", v)
+	}
+
+	_, err := db.ExecContext(ctx, `
+INSERT INTO log_projects(user_id, project, visibility, created_at, modified_at)
+VALUES ($1, $2, $3, $4, $4)
+ON CONFLICT (user_id, project) DO UPDATE
+SET (visibility, modified_at) = ($3, $4)
+WHERE log_projects.user_id = $1 AND log_projects.project = $2;
+`, u.ID, project, v, time.Now())
+
+	return err
+}
+
 // UserLogs gets all logs for a User.
 func UserLogs(ctx context.Context, u *User) ([]*Log, error) {
 	if u == nil {
 		return nil, fmt.Errorf("no user specified")
 	}
 
-	rows, err := db.QueryContext(
-		ctx,
-		"SELECT id, code, datetime, description, ST_AsBinary(location), project, user_id, created_at, modified_at FROM logs WHERE user_id = $1 ORDER BY datetime DESC",
-		u.ID)
+	return queryLogs(ctx, "SELECT "+logColumns+" FROM logs WHERE user_id = $1 AND "+logsVisibleTo(ctx, u)+" ORDER BY datetime DESC", u.ID)
+}
+
+// FilterLogs gets logs for a User that match all of the set fields in filter,
+// newest first.
+func FilterLogs(ctx context.Context, u *User, filter *LogFilter, limit, offset int) ([]*Log, error) {
+	if u == nil {
+		return nil, fmt.Errorf("no user specified")
+	}
+
+	args := []interface{}{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	where := []string{fmt.Sprintf("user_id = %s", arg(u.ID)), logsVisibleTo(ctx, u)}
+
+	if filter != nil {
+		if filter.Project != nil {
+			where = append(where, fmt.Sprintf("project = %s", arg(*filter.Project)))
+		}
+
+		if filter.Code != nil {
+			where = append(where, fmt.Sprintf("code = %s", arg(*filter.Code)))
+		}
+
+		if filter.From != nil {
+			where = append(where, fmt.Sprintf("datetime >= %s", arg(*filter.From)))
+		}
+
+		if filter.To != nil {
+			where = append(where, fmt.Sprintf("datetime < %s", arg(*filter.To)))
+		}
+
+		if filter.Search != nil {
+			where = append(where, fmt.Sprintf("description ILIKE '%%' || %s || '%%'", arg(*filter.Search)))
+		}
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM logs WHERE %s ORDER BY datetime DESC LIMIT %s OFFSET %s",
+		logColumns,
+		strings.Join(where, " AND "),
+		arg(limit),
+		arg(offset))
+
+	return queryLogs(ctx, query, args...)
+}
+
+// LogProjects returns every project a User has logged, with how many logs
+// each has, most used first.
+func LogProjects(ctx context.Context, u *User) ([]*Count, error) {
+	if u == nil {
+		return nil, fmt.Errorf("no user specified")
+	}
+
+	return queryCounts(ctx, "SELECT project, COUNT(*) AS cnt FROM logs WHERE user_id = $1 AND "+logsVisibleTo(ctx, u)+" GROUP BY project ORDER BY cnt DESC, project ASC", u.ID)
+}
+
+// LogCodes returns every code a User has logged, with how many logs each has,
+// most used first.
+func LogCodes(ctx context.Context, u *User) ([]*Count, error) {
+	if u == nil {
+		return nil, fmt.Errorf("no user specified")
+	}
+
+	return queryCounts(ctx, "SELECT code, COUNT(*) AS cnt FROM logs WHERE user_id = $1 AND "+logsVisibleTo(ctx, u)+" GROUP BY code ORDER BY cnt DESC, code ASC", u.ID)
+}
+
+// queryLogs runs a query that selects logColumns and returns the logs found.
+func queryLogs(ctx context.Context, query string, args ...interface{}) ([]*Log, error) {
+	return queryLogsFrom(ctx, nil, query, args...)
+}
+
+// queryLogsFrom is queryLogs for a query that also selects each log's distance
+// in meters from a point, after logColumns. The distance is kept so
+// DistanceFrom can return it without another query.
+func queryLogsFrom(ctx context.Context, from *Geo, query string, args ...interface{}) ([]*Log, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -107,25 +301,53 @@ func UserLogs(ctx context.Context, u *User) ([]*Log, error) {
 	logs := make([]*Log, 0)
 	for rows.Next() {
 		l := &Log{}
-		// var p orb.Point
 		var b []byte
+		var d sql.NullFloat64
+		var v sql.NullString
+		var dist sql.NullFloat64
 
-		err := rows.Scan(
+		dest := []interface{}{
 			&l.ID,
 			&l.Code,
 			&l.Datetime,
 			&l.Description,
-			&b, // Change to GeoScanner(&p) once https://github.com/paulmach/orb/issues/21 is fixed
+			&b,
 			&l.Project,
 			&l.User.ID,
 			&l.Created,
 			&l.Modified,
-		)
+			&d,
+			&v,
+			&l.EffectiveVisibility,
+		}
+		if from != nil {
+			dest = append(dest, &dist)
+		}
+
+		err := rows.Scan(dest...)
 		if err != nil {
 			return nil, err
 		}
 
-		// l.Location = GeoFromOrb(b)
+		if from != nil && dist.Valid {
+			l.distanceFrom = *from
+			l.distance = &dist.Float64
+		}
+
+		l.Location, err = GeoFromWKB(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if d.Valid {
+			dur := NewDuration(d.Float64)
+			l.Duration = &dur
+		}
+
+		if v.Valid {
+			vis := Visibility(v.String)
+			l.Visibility = &vis
+		}
 
 		logs = append(logs, l)
 	}
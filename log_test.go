@@ -0,0 +1,171 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestLogSaveRoundTrip(t *testing.T) {
+	testDB(t)
+
+	u := testUser(t, RoleNormal)
+	ctx := WithUser(context.Background(), u)
+
+	dur := NewDuration(1800)
+	l := &Log{
+		Code:        "test",
+		Datetime:    time.Now().Truncate(time.Second),
+		Description: "round trip",
+		Location:    &Geo{Lat: 51.5007, Long: -0.1246},
+		Project:     "tests",
+		User:        *u,
+		Duration:    &dur,
+	}
+
+	if err := l.Save(ctx); err != nil {
+		t.Fatalf("Save: %+v", err)
+	}
+
+	got, err := GetLog(ctx, l.ID)
+	if err != nil {
+		t.Fatalf("GetLog: %+v", err)
+	}
+
+	if got.Duration == nil || got.Duration.Seconds() != 1800 {
+		t.Errorf("Duration = %+v, want 1800", got.Duration)
+	}
+
+	if got.Location == nil || *got.Location != *l.Location {
+		t.Errorf("Location = %+v, want %+v", got.Location, l.Location)
+	}
+
+	l.Duration = nil
+	l.Location = nil
+	if err := l.Save(ctx); err != nil {
+		t.Fatalf("Save: %+v", err)
+	}
+
+	got, err = GetLog(ctx, l.ID)
+	if err != nil {
+		t.Fatalf("GetLog: %+v", err)
+	}
+
+	if got.Duration != nil || got.Location != nil {
+		t.Errorf("got %+v, %+v, want both cleared", got.Duration, got.Location)
+	}
+}
+
+func TestLogVisibility(t *testing.T) {
+	testDB(t)
+
+	owner := testUser(t, RoleNormal)
+	ownerCtx := WithUser(context.Background(), owner)
+
+	if err := SetLogProjectVisibility(ownerCtx, owner, "public", VisibilityPublic); err != nil {
+		t.Fatalf("SetLogProjectVisibility: %+v", err)
+	}
+
+	if err := SetLogProjectVisibility(ownerCtx, owner, "members", VisibilityLoggedIn); err != nil {
+		t.Fatalf("SetLogProjectVisibility: %+v", err)
+	}
+
+	ids := map[string]string{}
+	for _, project := range []string{"public", "members", "private"} {
+		l := &Log{
+			Code:     "test",
+			Datetime: time.Now(),
+			Project:  project,
+			User:     *owner,
+		}
+
+		if err := l.Save(ownerCtx); err != nil {
+			t.Fatalf("Save: %+v", err)
+		}
+		ids[project] = l.ID
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want []string
+	}{
+		{"anonymous", context.Background(), []string{"public"}},
+		{"logged in", WithUser(context.Background(), testUser(t, RoleNormal)), []string{"public", "members"}},
+		{"owner", ownerCtx, []string{"public", "members", "private"}},
+		{"admin", WithUser(context.Background(), testUser(t, RoleAdmin)), []string{"public", "members", "private"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			want := map[string]bool{}
+			for _, p := range tc.want {
+				want[p] = true
+			}
+
+			u, err := logsUser(tc.ctx, &owner.ID)
+			if err != nil {
+				t.Fatalf("logsUser: %+v", err)
+			}
+
+			logs, err := UserLogs(tc.ctx, u)
+			if err != nil {
+				t.Fatalf("UserLogs: %+v", err)
+			}
+			if len(logs) != len(want) {
+				t.Errorf("UserLogs returned %d logs, want %d", len(logs), len(want))
+			}
+
+			logs, err = FilterLogs(tc.ctx, u, &LogFilter{}, 10, 0)
+			if err != nil {
+				t.Fatalf("FilterLogs: %+v", err)
+			}
+			for _, l := range logs {
+				if !want[l.Project] {
+					t.Errorf("FilterLogs returned a log in %q", l.Project)
+				}
+			}
+			if len(logs) != len(want) {
+				t.Errorf("FilterLogs returned %d logs, want %d", len(logs), len(want))
+			}
+
+			projects, err := LogProjects(tc.ctx, u)
+			if err != nil {
+				t.Fatalf("LogProjects: %+v", err)
+			}
+			for _, c := range projects {
+				if !want[c.Key] {
+					t.Errorf("LogProjects returned %q", c.Key)
+				}
+			}
+			if len(projects) != len(want) {
+				t.Errorf("LogProjects returned %d projects, want %d", len(projects), len(want))
+			}
+
+			for project, id := range ids {
+				_, err := GetLog(tc.ctx, id)
+				if want[project] && err != nil {
+					t.Errorf("GetLog in %q: %+v", project, err)
+				}
+				if !want[project] && err == nil {
+					t.Errorf("GetLog returned a log in %q", project)
+				}
+			}
+		})
+	}
+}
+
+func TestLogsUserDoesNotCreateUsers(t *testing.T) {
+	testDB(t)
+
+	id := "test-missing-" + uuid.New().String()
+	if _, err := logsUser(context.Background(), &id); err == nil {
+		t.Fatal("expected an error for a missing user")
+	}
+
+	if _, err := FindUser(context.Background(), id); err == nil {
+		t.Error("logsUser created the missing user")
+	}
+}
@@ -0,0 +1,89 @@
+package graphql
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// icalTimeFormat is the UTC date-time format from RFC 5545.
+const icalTimeFormat = "20060102T150405Z"
+
+// icalEscaper escapes text values as described in RFC 5545 section 3.3.11.
+var icalEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// WriteLogsICalendar writes logs as an iCalendar with a VEVENT for each log.
+// Events start at the log's datetime and last for its duration, with the
+// project as the category and the location as the geo.
+func WriteLogsICalendar(w io.Writer, logs []*Log) error {
+	bw := bufio.NewWriter(w)
+	line := func(format string, a ...interface{}) {
+		icalLine(bw, fmt.Sprintf(format, a...))
+	}
+
+	now := time.Now().UTC().Format(icalTimeFormat)
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//icco//graphql logs//EN")
+	line("CALSCALE:GREGORIAN")
+	line("X-WR-CALNAME:Logs")
+
+	for _, l := range logs {
+		start := l.Datetime.UTC()
+
+		line("BEGIN:VEVENT")
+		line("UID:%s@graphql.natwelch.com", l.ID)
+		line("DTSTAMP:%s", now)
+		line("DTSTART:%s", start.Format(icalTimeFormat))
+		if l.Duration != nil && l.Duration.Seconds() > 0 {
+			line("DTEND:%s", start.Add(time.Duration(l.Duration.Seconds()*float64(time.Second))).Format(icalTimeFormat))
+		}
+		line("SUMMARY:%s", icalEscaper.Replace(fmt.Sprintf("%s: %s", l.Project, l.Code)))
+		if l.Description != "" {
+			line("DESCRIPTION:%s", icalEscaper.Replace(l.Description))
+		}
+		if l.Project != "" {
+			line("CATEGORIES:%s", icalEscaper.Replace(l.Project))
+		}
+		if l.Location != nil {
+			line("GEO:%f;%f", l.Location.Lat, l.Location.Long)
+		}
+		line("LAST-MODIFIED:%s", l.Modified.UTC().Format(icalTimeFormat))
+		line("END:VEVENT")
+	}
+
+	line("END:VCALENDAR")
+
+	return bw.Flush()
+}
+
+// icalLine writes a content line, folding it so no line is longer than 75
+// octets, without splitting UTF-8 characters.
+func icalLine(w *bufio.Writer, s string) {
+	limit := 75
+	for len(s) > limit {
+		i := limit
+		for i > 0 && s[i]&0xC0 == 0x80 {
+			i--
+		}
+
+		w.WriteString(s[:i])
+		w.WriteString("\r\n ")
+		s = s[i:]
+
+		// Continuation lines start with a space, which counts towards the limit.
+		limit = 74
+	}
+
+	w.WriteString(s)
+	w.WriteString("\r\n")
+}
@@ -0,0 +1,142 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/paulmach/orb/geojson"
+)
+
+// LogsNear returns a User's logs within radius meters of a point, closest
+// first.
+func LogsNear(ctx context.Context, u *User, lat, long, radius float64, limit, offset int) ([]*Log, error) {
+	if u == nil {
+		return nil, fmt.Errorf("no user specified")
+	}
+
+	if err := validLatLong(lat, long); err != nil {
+		return nil, err
+	}
+
+	if radius <= 0 {
+		return nil, fmt.Errorf("radius must be positive")
+	}
+
+	// The distance is selected so it matches the ordering, which is on the
+	// spheroid rather than a sphere.
+	query := `
+SELECT ` + logColumns + `, ST_Distance(location, ST_SetSRID(ST_MakePoint($3, $2), 4326)::geography) AS distance
+FROM logs
+WHERE user_id = $1
+  AND ` + logsVisibleTo(ctx, u) + `
+  AND ST_DWithin(location, ST_SetSRID(ST_MakePoint($3, $2), 4326)::geography, $4)
+ORDER BY distance ASC, datetime DESC
+LIMIT $5 OFFSET $6`
+
+	return queryLogsFrom(ctx, &Geo{Lat: lat, Long: long}, query, u.ID, lat, long, radius, limit, offset)
+}
+
+// LogsInBoundingBox returns a User's logs inside of a box of latitudes and
+// longitudes, newest first. If minLong is greater than maxLong, the box
+// crosses the antimeridian.
+func LogsInBoundingBox(ctx context.Context, u *User, minLat, minLong, maxLat, maxLong float64, limit, offset int) ([]*Log, error) {
+	if u == nil {
+		return nil, fmt.Errorf("no user specified")
+	}
+
+	if err := validLatLong(minLat, minLong); err != nil {
+		return nil, err
+	}
+
+	if err := validLatLong(maxLat, maxLong); err != nil {
+		return nil, err
+	}
+
+	if minLat > maxLat {
+		return nil, fmt.Errorf("minimum latitude must not be greater than maximum latitude")
+	}
+
+	box := "location::geometry && ST_MakeEnvelope($3, $2, $5, $4, 4326)"
+	if minLong > maxLong {
+		box = "(location::geometry && ST_MakeEnvelope($3, $2, 180, $4, 4326) OR location::geometry && ST_MakeEnvelope(-180, $2, $5, $4, 4326))"
+	}
+
+	query := `
+SELECT ` + logColumns + `
+FROM logs
+WHERE user_id = $1
+  AND ` + logsVisibleTo(ctx, u) + `
+  AND ` + box + `
+ORDER BY datetime DESC
+LIMIT $6 OFFSET $7`
+
+	return queryLogs(ctx, query, u.ID, minLat, minLong, maxLat, maxLong, limit, offset)
+}
+
+// DistanceFrom returns how many meters this log is from a point, or nil if the
+// log has no location. It is measured by PostGIS on the spheroid, the same as
+// LogsNear orders logs by.
+func (l *Log) DistanceFrom(ctx context.Context, lat, long float64) (*float64, error) {
+	if l.Location == nil {
+		return nil, nil
+	}
+
+	if l.distance != nil && l.distanceFrom == (Geo{Lat: lat, Long: long}) {
+		return l.distance, nil
+	}
+
+	if err := validLatLong(lat, long); err != nil {
+		return nil, err
+	}
+
+	loc, err := GeoConvertValue(l.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	var d float64
+	row := db.QueryRowContext(ctx, "SELECT ST_Distance(ST_GeogFromWKB($1), ST_SetSRID(ST_MakePoint($3, $2), 4326)::geography)", loc, lat, long)
+	if err := row.Scan(&d); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// LogsGeoJSON returns a FeatureCollection with a Point for every log that has
+// a location.
+func LogsGeoJSON(logs []*Log) *geojson.FeatureCollection {
+	fc := geojson.NewFeatureCollection()
+	for _, l := range logs {
+		if l.Location == nil {
+			continue
+		}
+
+		f := geojson.NewFeature(*l.Location.ToOrb())
+		f.ID = l.ID
+		f.Properties["code"] = l.Code
+		f.Properties["project"] = l.Project
+		f.Properties["description"] = l.Description
+		f.Properties["datetime"] = l.Datetime.Format(time.RFC3339)
+		if l.Duration != nil {
+			f.Properties["duration"] = l.Duration.Seconds()
+		}
+
+		fc.Append(f)
+	}
+
+	return fc
+}
+
+func validLatLong(lat, long float64) error {
+	if lat < -90 || lat > 90 {
+		return fmt.Errorf("latitude must be between -90 and 90")
+	}
+
+	if long < -180 || long > 180 {
+		return fmt.Errorf("longitude must be between -180 and 180")
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// logReportPeriods maps the time based groupings to their date_trunc field.
+var logReportPeriods = map[LogReportGroup]string{
+	LogReportGroupDay:   "day",
+	LogReportGroupWeek:  "week",
+	LogReportGroupMonth: "month",
+}
+
+// GetLogReport sums up the durations of a User's logs between from and to,
+// grouped by the fields in groupBy. Days, weeks and months are bucketed in
+// the timezone tz, which is an IANA name such as "America/New_York", and
+// periods are returned as the instant each bucket starts. At most one of DAY,
+// WEEK and MONTH may be given.
+func GetLogReport(ctx context.Context, u *User, from, to *time.Time, groupBy []LogReportGroup, tz string) ([]*LogReportRow, error) {
+	if u == nil {
+		return nil, fmt.Errorf("no user specified")
+	}
+
+	if tz == "" {
+		tz = "UTC"
+	}
+
+	if _, err := time.LoadLocation(tz); err != nil {
+		return nil, fmt.Errorf("%q is not a valid timezone", tz)
+	}
+
+	args := []interface{}{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	project := "NULL::text"
+	code := "NULL::text"
+	period := "NULL::timestamp with time zone"
+	group := []string{}
+
+	for _, g := range groupBy {
+		switch g {
+		case LogReportGroupProject:
+			project = "project"
+			group = append(group, "project")
+		case LogReportGroupCode:
+			code = "code"
+			group = append(group, "code")
+		case LogReportGroupDay, LogReportGroupWeek, LogReportGroupMonth:
+			if !strings.HasPrefix(period, "NULL") {
+				return nil, fmt.Errorf("only one of DAY, WEEK and MONTH can be grouped by")
+			}
+			p := arg(tz)
+			period = fmt.Sprintf("(date_trunc('%s', datetime AT TIME ZONE %s) AT TIME ZONE %s)", logReportPeriods[g], p, p)
+			group = append(group, "period")
+		default:
+			return nil, fmt.Errorf("%s is not a valid LogReportGroup", g)
+		}
+	}
+
+	where := []string{fmt.Sprintf("user_id = %s", arg(u.ID))}
+	if from != nil {
+		where = append(where, fmt.Sprintf("datetime >= %s", arg(*from)))
+	}
+
+	if to != nil {
+		where = append(where, fmt.Sprintf("datetime < %s", arg(*to)))
+	}
+
+	query := fmt.Sprintf(`
+SELECT %s AS project, %s AS code, %s AS period, COALESCE(SUM(duration), 0), COUNT(*)
+FROM logs
+WHERE %s`, project, code, period, strings.Join(where, " AND "))
+
+	if len(group) > 0 {
+		query += fmt.Sprintf("\nGROUP BY %s\nORDER BY %s", strings.Join(group, ", "), strings.Join(group, ", "))
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	report := make([]*LogReportRow, 0)
+	for rows.Next() {
+		r := &LogReportRow{}
+		err := rows.Scan(&r.Project, &r.Code, &r.Period, &r.Duration, &r.Count)
+		if err != nil {
+			return nil, err
+		}
+		report = append(report, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
@@ -1,6 +1,7 @@
 package graphql
 
 import (
+	"fmt"
 	"html/template"
 	"regexp"
 	"strings"
@@ -14,6 +15,10 @@ var (
 
 	// TwitterHandleRegex is a regex for finding @username in Markdown.
 	TwitterHandleRegex = regexp.MustCompile(`(\s)@([_A-Za-z0-9]+)`)
+
+	// WikiLinkRegex is a regex for finding [[Page Title]] and [[slug|label]]
+	// links in Markdown.
+	WikiLinkRegex = regexp.MustCompile(`\[\[([^\[\]|]+)(?:\|([^\[\]]+))?\]\]`)
 )
 
 // Markdown generator.
@@ -26,6 +31,28 @@ func Markdown(str string) template.HTML {
 	return template.HTML(s)
 }
 
+// WikiMarkdown is like Markdown, but also turns wiki links into links to
+// pages.
+func WikiMarkdown(str string) template.HTML {
+	return Markdown(string(wikiLinksToMarkdown([]byte(str))))
+}
+
+// WikiLinks returns the slugs of every page linked to in a chunk of Markdown,
+// without duplicates, in the order they first appear.
+func WikiLinks(str string) []string {
+	slugs := []string{}
+	seen := map[string]bool{}
+	for _, m := range WikiLinkRegex.FindAllStringSubmatch(str, -1) {
+		slug := Slugify(m[1])
+		if slug != "" && !seen[slug] {
+			seen[slug] = true
+			slugs = append(slugs, slug)
+		}
+	}
+
+	return slugs
+}
+
 // SummarizeText takes a chunk of markdown and just returns the first paragraph.
 func SummarizeText(str string) string {
 	out := strings.Split(str, "\n")
@@ -39,3 +66,16 @@ func twitterHandleToMarkdown(in []byte) []byte {
 func hashTagsToMarkdown(in []byte) []byte {
 	return HashtagRegex.ReplaceAll(in, []byte("$1[#$2](/tags/$2)"))
 }
+
+func wikiLinksToMarkdown(in []byte) []byte {
+	return WikiLinkRegex.ReplaceAllFunc(in, func(link []byte) []byte {
+		m := WikiLinkRegex.FindSubmatch(link)
+		target := strings.TrimSpace(string(m[1]))
+		label := strings.TrimSpace(string(m[2]))
+		if label == "" {
+			label = target
+		}
+
+		return []byte(fmt.Sprintf("[%s](/page/%s)", label, Slugify(target)))
+	})
+}
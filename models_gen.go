@@ -17,15 +17,74 @@ type Searchable interface {
 	IsSearchable()
 }
 
+// Breadcrumb is one step of the path to a page.
+type Breadcrumb struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// Category is a node in the tree of page categories.
+type Category struct {
+	// The full path, like recipes/baking.
+	Path string `json:"path"`
+	// The last part of the path, like baking.
+	Name string `json:"name"`
+	// How many pages are directly in this category.
+	Count int `json:"count"`
+	// How many pages are in this category and every category beneath it.
+	Total    int         `json:"total"`
+	Children []*Category `json:"children"`
+}
+
 // Comment is an undefined type reserved for the future.
 type Comment struct {
 	ID string `json:"id"`
 }
 
+// A Count is a value and the number of times it appears.
+type Count struct {
+	Key   string `json:"key"`
+	Count int    `json:"count"`
+}
+
+// DiffLine is one line of a diff.
+type DiffLine struct {
+	Op   DiffOp `json:"op"`
+	Text string `json:"text"`
+}
+
 type EditBook struct {
-	ID          *string `json:"id"`
-	Title       *string `json:"title"`
-	GoodreadsID string  `json:"goodreads_id"`
+	ID          *string    `json:"id"`
+	Title       *string    `json:"title"`
+	GoodreadsID string     `json:"goodreads_id"`
+	Authors     []string   `json:"authors"`
+	Isbn        *string    `json:"isbn"`
+	Shelf       *Shelf     `json:"shelf"`
+	Started     *time.Time `json:"started"`
+	Finished    *time.Time `json:"finished"`
+	Rating      *int       `json:"rating"`
+	Review      *string    `json:"review"`
+	Pages       *int       `json:"pages"`
+	// Set to true to remove when the book was started.
+	ClearStarted *bool `json:"clear_started"`
+	// Set to true to remove when the book was finished.
+	ClearFinished *bool `json:"clear_finished"`
+	// Set to true to remove the book's rating.
+	ClearRating *bool `json:"clear_rating"`
+}
+
+// EditLog changes an existing log. Fields that are not set are left alone.
+type EditLog struct {
+	ID          string      `json:"id"`
+	Code        *string     `json:"code"`
+	Datetime    *time.Time  `json:"datetime"`
+	Description *string     `json:"description"`
+	Location    *NewGeo     `json:"location"`
+	Project     *string     `json:"project"`
+	Duration    *string     `json:"duration"`
+	Visibility  *Visibility `json:"visibility"`
+	// Set to true to make the log use the visibility of its project again.
+	ClearVisibility *bool `json:"clearVisibility"`
 }
 
 type EditPage struct {
@@ -36,8 +95,22 @@ type EditPage struct {
 	Category *string `json:"category"`
 }
 
+// EditPagePermissions changes who can see and change a page. Fields that are
+// not set are left alone.
+type EditPagePermissions struct {
+	ID         string      `json:"id"`
+	Visibility *Visibility `json:"visibility"`
+	EditRole   *Role       `json:"editRole"`
+	// Set to true to stop any role from editing the page.
+	ClearEditRole *bool    `json:"clearEditRole"`
+	Editors       []string `json:"editors"`
+	// A new owner for the page. Only admins can set this.
+	Owner *string `json:"owner"`
+}
+
 type EditPost struct {
 	ID       *string    `json:"id"`
+	Slug     *string    `json:"slug"`
 	Content  *string    `json:"content"`
 	Title    *string    `json:"title"`
 	Datetime *time.Time `json:"datetime"`
@@ -49,6 +122,51 @@ type Limit struct {
 	Offset *int `json:"offset"`
 }
 
+// LinkFilter narrows down a query for links. All set fields must match.
+type LinkFilter struct {
+	// Only return links that have this tag.
+	Tag *string `json:"tag"`
+	// Only return links whose host is this domain or a subdomain of it.
+	Domain *string `json:"domain"`
+	// Only return links whose title or description contains this text.
+	Search *string `json:"search"`
+	// Only return links created at or after this time.
+	From *time.Time `json:"from"`
+	// Only return links created before this time.
+	To *time.Time `json:"to"`
+}
+
+// LogFilter narrows down a query for logs. All set fields must match.
+type LogFilter struct {
+	Project *string `json:"project"`
+	Code    *string `json:"code"`
+	// Only return logs at or after this time.
+	From *time.Time `json:"from"`
+	// Only return logs before this time.
+	To *time.Time `json:"to"`
+	// Only return logs whose description contains this text.
+	Search *string `json:"search"`
+}
+
+// A LogReportRow is the total time logged in one group of a log report. Fields
+// that were not grouped by are null.
+type LogReportRow struct {
+	Project *string `json:"project"`
+	Code    *string `json:"code"`
+	// The start of the day, week or month this row covers.
+	Period   *time.Time `json:"period"`
+	Duration Duration   `json:"duration"`
+	Count    int        `json:"count"`
+}
+
+type NewAlbum struct {
+	Title        string  `json:"title"`
+	Description  *string `json:"description"`
+	CoverPhotoID *string `json:"coverPhotoId"`
+	// The photos in the album, in order.
+	PhotoIds []string `json:"photoIds"`
+}
+
 type NewGeo struct {
 	Lat  float64 `json:"lat"`
 	Long float64 `json:"long"`
@@ -63,11 +181,12 @@ type NewLink struct {
 }
 
 type NewLog struct {
-	Code        string  `json:"code"`
-	Description *string `json:"description"`
-	Location    *NewGeo `json:"location"`
-	Project     string  `json:"project"`
-	Duration    *string `json:"duration"`
+	Code        string      `json:"code"`
+	Description *string     `json:"description"`
+	Location    *NewGeo     `json:"location"`
+	Project     string      `json:"project"`
+	Duration    *string     `json:"duration"`
+	Visibility  *Visibility `json:"visibility"`
 }
 
 type NewStat struct {
@@ -76,16 +195,77 @@ type NewStat struct {
 }
 
 type NewTweet struct {
-	FavoriteCount int       `json:"favorite_count"`
-	Hashtags      []string  `json:"hashtags"`
-	ID            string    `json:"id"`
-	Posted        time.Time `json:"posted"`
-	RetweetCount  int       `json:"retweet_count"`
-	Symbols       []string  `json:"symbols"`
-	Text          string    `json:"text"`
-	Urls          []URI     `json:"urls"`
-	ScreenName    string    `json:"screen_name"`
-	UserMentions  []string  `json:"user_mentions"`
+	FavoriteCount       int       `json:"favorite_count"`
+	Hashtags            []string  `json:"hashtags"`
+	ID                  string    `json:"id"`
+	Posted              time.Time `json:"posted"`
+	RetweetCount        int       `json:"retweet_count"`
+	Symbols             []string  `json:"symbols"`
+	Text                string    `json:"text"`
+	Urls                []URI     `json:"urls"`
+	ScreenName          string    `json:"screen_name"`
+	UserMentions        []string  `json:"user_mentions"`
+	InReplyToStatusID   *string   `json:"in_reply_to_status_id"`
+	InReplyToScreenName *string   `json:"in_reply_to_screen_name"`
+	QuotedStatusID      *string   `json:"quoted_status_id"`
+	ConversationID      *string   `json:"conversation_id"`
+}
+
+// PageDiff is the changes to a page's content between two revisions.
+type PageDiff struct {
+	From  PageRevision `json:"from"`
+	To    PageRevision `json:"to"`
+	Lines []DiffLine   `json:"lines"`
+	// The lines prefixed with a space, + or -.
+	Unified string `json:"unified"`
+}
+
+// PagePermissions describes who can see and change a page. Admins can always
+// do both.
+type PagePermissions struct {
+	Visibility Visibility `json:"visibility"`
+	// Who created the page. They can always edit it and change its permissions.
+	Owner *User `json:"owner"`
+	// Users with this role can edit the page.
+	EditRole *Role `json:"editRole"`
+	// IDs of users who can edit the page.
+	Editors []string `json:"editors"`
+	// Whether you can see the page.
+	CanView bool `json:"canView"`
+	// Whether you can edit the page.
+	CanEdit bool `json:"canEdit"`
+}
+
+// ReadingChallenge is progress towards a goal of books to read in a year.
+type ReadingChallenge struct {
+	Year int `json:"year"`
+	Goal int `json:"goal"`
+	Read int `json:"read"`
+	// read divided by goal.
+	Progress float64 `json:"progress"`
+	// How many books should have been read by now to be on track.
+	Expected int `json:"expected"`
+	// How many books we are ahead of expected. Negative if behind.
+	Ahead int `json:"ahead"`
+}
+
+// ReadingStats summarizes the books we have finished.
+type ReadingStats struct {
+	// The year these stats cover, or null for all time.
+	Year *int `json:"year"`
+	// How many books were finished each year, oldest first. Keys are YYYY. Not limited by year.
+	BooksPerYear []*Count `json:"booksPerYear"`
+	// How many books were finished each month, oldest first. Keys are YYYY-MM.
+	BooksPerMonth []*Count `json:"booksPerMonth"`
+	BooksRead     int      `json:"booksRead"`
+	// The sum of pages of every book read that we know the length of.
+	PagesRead int `json:"pagesRead"`
+	// The average rating of rated books, or null if none are rated.
+	AverageRating *float64 `json:"averageRating"`
+	// The ten authors we read the most books by.
+	TopAuthors []*Count `json:"topAuthors"`
+	// Progress towards the reading goal for the year, or the current year if no year is given.
+	Challenge *ReadingChallenge `json:"challenge"`
 }
 
 // A stat is a key value pair of two interesting strings.
@@ -94,6 +274,149 @@ type Stat struct {
 	Value string `json:"value"`
 }
 
+// WikiLink is a link from one page to another by slug.
+type WikiLink struct {
+	Slug string `json:"slug"`
+	// The linked page, or null if there is no page with this slug yet.
+	Page *Page `json:"page"`
+}
+
+// BookImportFormatIsTheFormatOfALibraryExportToImportBooksFrom.
+type BookImportFormat string
+
+const (
+	// The CSV from Export Library on Goodreads.
+	BookImportFormatGoodreads BookImportFormat = "goodreads"
+	// Open Library records, as a JSON array, one per line, or a dump file.
+	BookImportFormatOpenlibrary BookImportFormat = "openlibrary"
+)
+
+var AllBookImportFormat = []BookImportFormat{
+	BookImportFormatGoodreads,
+	BookImportFormatOpenlibrary,
+}
+
+func (e BookImportFormat) IsValid() bool {
+	switch e {
+	case BookImportFormatGoodreads, BookImportFormatOpenlibrary:
+		return true
+	}
+	return false
+}
+
+func (e BookImportFormat) String() string {
+	return string(e)
+}
+
+func (e *BookImportFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BookImportFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BookImportFormat", str)
+	}
+	return nil
+}
+
+func (e BookImportFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// DiffOpIsWhatHappenedToALineBetweenTwoRevisions.
+type DiffOp string
+
+const (
+	DiffOpEqual  DiffOp = "equal"
+	DiffOpInsert DiffOp = "insert"
+	DiffOpDelete DiffOp = "delete"
+)
+
+var AllDiffOp = []DiffOp{
+	DiffOpEqual,
+	DiffOpInsert,
+	DiffOpDelete,
+}
+
+func (e DiffOp) IsValid() bool {
+	switch e {
+	case DiffOpEqual, DiffOpInsert, DiffOpDelete:
+		return true
+	}
+	return false
+}
+
+func (e DiffOp) String() string {
+	return string(e)
+}
+
+func (e *DiffOp) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DiffOp(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DiffOp", str)
+	}
+	return nil
+}
+
+func (e DiffOp) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// LogReportGroupIsAFieldALogReportCanBeGroupedBy.
+type LogReportGroup string
+
+const (
+	LogReportGroupProject LogReportGroup = "PROJECT"
+	LogReportGroupCode    LogReportGroup = "CODE"
+	LogReportGroupDay     LogReportGroup = "DAY"
+	LogReportGroupWeek    LogReportGroup = "WEEK"
+	LogReportGroupMonth   LogReportGroup = "MONTH"
+)
+
+var AllLogReportGroup = []LogReportGroup{
+	LogReportGroupProject,
+	LogReportGroupCode,
+	LogReportGroupDay,
+	LogReportGroupWeek,
+	LogReportGroupMonth,
+}
+
+func (e LogReportGroup) IsValid() bool {
+	switch e {
+	case LogReportGroupProject, LogReportGroupCode, LogReportGroupDay, LogReportGroupWeek, LogReportGroupMonth:
+		return true
+	}
+	return false
+}
+
+func (e LogReportGroup) String() string {
+	return string(e)
+}
+
+func (e *LogReportGroup) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LogReportGroup(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LogReportGroup", str)
+	}
+	return nil
+}
+
+func (e LogReportGroup) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Role string
 
 const (
@@ -134,3 +457,94 @@ func (e *Role) UnmarshalGQL(v interface{}) error {
 func (e Role) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+// ShelfIsWhereABookIsInOurReading.
+type Shelf string
+
+const (
+	ShelfToRead  Shelf = "to_read"
+	ShelfReading Shelf = "reading"
+	ShelfRead    Shelf = "read"
+)
+
+var AllShelf = []Shelf{
+	ShelfToRead,
+	ShelfReading,
+	ShelfRead,
+}
+
+func (e Shelf) IsValid() bool {
+	switch e {
+	case ShelfToRead, ShelfReading, ShelfRead:
+		return true
+	}
+	return false
+}
+
+func (e Shelf) String() string {
+	return string(e)
+}
+
+func (e *Shelf) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Shelf(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Shelf", str)
+	}
+	return nil
+}
+
+func (e Shelf) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// VisibilityIsWhoCanSeeSomething.
+type Visibility string
+
+const (
+	// Anyone, even if they are not logged in.
+	VisibilityPublic Visibility = "public"
+	// Anyone who is logged in.
+	VisibilityLoggedIn Visibility = "logged_in"
+	// Only its owner and admins.
+	VisibilityPrivate Visibility = "private"
+)
+
+var AllVisibility = []Visibility{
+	VisibilityPublic,
+	VisibilityLoggedIn,
+	VisibilityPrivate,
+}
+
+func (e Visibility) IsValid() bool {
+	switch e {
+	case VisibilityPublic, VisibilityLoggedIn, VisibilityPrivate:
+		return true
+	}
+	return false
+}
+
+func (e Visibility) String() string {
+	return string(e)
+}
+
+func (e *Visibility) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Visibility(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Visibility", str)
+	}
+	return nil
+}
+
+func (e Visibility) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
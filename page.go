@@ -22,6 +22,12 @@ type Page struct {
 	User     User      `json:"user"`
 	Created  time.Time `json:"created"`
 	Modified time.Time `json:"modified"`
+
+	// Owner created the page, while User last edited it.
+	Owner      User
+	Visibility Visibility
+	EditRole   *Role
+	Editors    []string
 }
 
 // Save inserts or updates a page into the database.
@@ -35,7 +41,34 @@ func (p *Page) Save(ctx context.Context) error {
 	}
 
 	if p.Slug == "" {
-		p.Slug = Slugify(p.Title)
+		p.Slug = p.Title
+	}
+
+	old, err := currentSlug(ctx, "page", p.ID)
+	if err != nil {
+		return err
+	}
+
+	p.Category = CleanCategory(p.Category)
+
+	if p.Owner.Empty() {
+		p.Owner = p.User
+	}
+
+	if p.Visibility == "" {
+		p.Visibility = VisibilityPublic
+	}
+
+	if !p.Visibility.IsValid() {
+		return fmt.Errorf("%s is not a valid visibility", p.Visibility)
+	}
+
+	if p.EditRole != nil && !p.EditRole.IsValid() {
+		return fmt.Errorf("%s is not a valid role", *p.EditRole)
+	}
+
+	if p.Editors == nil {
+		p.Editors = []string{}
 	}
 
 	tags, err := ParseTags(p.Content)
@@ -50,13 +83,36 @@ func (p *Page) Save(ctx context.Context) error {
 
 	p.Modified = time.Now()
 
-	if _, err := db.ExecContext(
+	return saveWithUniqueSlug(ctx, "page", p.ID, Slugify(p.Slug), func(slug string) error {
+		p.Slug = slug
+
+		// The page, its links, its revision and the redirect from its old slug
+		// are saved together, so a failure part way through does not leave
+		// them out of step.
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		if err := p.save(ctx, tx, old); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		return tx.Commit()
+	})
+}
+
+// save writes the page, the redirect from its old slug, its links and a new
+// revision.
+func (p *Page) save(ctx context.Context, ex execer, old string) error {
+	if _, err := ex.ExecContext(
 		ctx,
 		`
-INSERT INTO pages(id, slug, title, content, category, tags, user_id, created_at, modified_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+INSERT INTO pages(id, slug, title, content, category, tags, user_id, created_at, modified_at, owner_id, visibility, edit_role, editors)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 ON CONFLICT (id) DO UPDATE
-SET (slug, title, content, category, tags, user_id, created_at, modified_at) = ($2, $3, $4, $5, $6, $7, $8, $9)
+SET (slug, title, content, category, tags, user_id, created_at, modified_at, owner_id, visibility, edit_role, editors) = ($2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 WHERE pages.id = $1;
 `,
 		p.ID,
@@ -67,11 +123,23 @@ WHERE pages.id = $1;
 		pq.Array(p.Tags),
 		p.User.ID,
 		p.Created,
-		p.Modified); err != nil {
+		p.Modified,
+		p.Owner.ID,
+		p.Visibility,
+		p.EditRole,
+		pq.Array(p.Editors)); err != nil {
 		return err
 	}
 
-	return nil
+	if err := recordSlugChange(ctx, ex, "page", p.ID, old, p.Slug); err != nil {
+		return err
+	}
+
+	if err := p.saveLinks(ctx, ex); err != nil {
+		return err
+	}
+
+	return p.saveRevision(ctx, ex)
 }
 
 // Slugify returns a dash seperated string that doesn't have unicode chars.
@@ -79,59 +147,56 @@ func Slugify(title string) string {
 	return slug.Make(title)
 }
 
+// pageColumns are the columns selected for every page query, in the order
+// queryPages expects them.
+const pageColumns = "id, slug, title, content, category, tags, user_id, created_at, modified_at, COALESCE(owner_id, ''), visibility, edit_role, editors"
+
 // GetPageByID gets a page by ID from the database.
 func GetPageByID(ctx context.Context, id string) (*Page, error) {
-	var p Page
-	var userID string
-
-	row := db.QueryRowContext(ctx, "SELECT id, slug, title, content, category, tags, user_id, created_at, modified_at FROM pages WHERE id = $1", id)
-	err := row.Scan(&p.ID, &p.Slug, &p.Title, &p.Content, &p.Category, pq.Array(&p.Tags), &userID, &p.Created, &p.Modified)
+	pages, err := queryPages(ctx, "SELECT "+pageColumns+" FROM pages WHERE id = $1", id)
 	switch {
-	case err == sql.ErrNoRows:
-		return nil, fmt.Errorf("No post with id")
 	case err != nil:
 		return nil, fmt.Errorf("Error running get query: %+v", err)
+	case len(pages) == 0:
+		return nil, fmt.Errorf("No post with id")
 	default:
-		u, err := GetUser(ctx, userID)
-		if err != nil {
-			return nil, err
-		}
-
-		if u != nil {
-			p.User = *u
-		}
-		return &p, nil
+		return pages[0], nil
 	}
 }
 
-// GetPageBySlug gets a page by ID from the database.
+// GetPageBySlug gets a page by slug from the database. If no page has the
+// slug, but a page used to, it returns that page.
 func GetPageBySlug(ctx context.Context, slug string) (*Page, error) {
-	var p Page
-	var userID string
+	pages, err := queryPages(ctx, "SELECT "+pageColumns+" FROM pages WHERE slug = $1", slug)
+	if err != nil {
+		return nil, fmt.Errorf("Error running get query: %+v", err)
+	}
 
-	row := db.QueryRowContext(ctx, "SELECT id, slug, title, content, category, tags, user_id, created_at, modified_at FROM pages WHERE slug = $1", slug)
-	err := row.Scan(&p.ID, &p.Slug, &p.Title, &p.Content, &p.Category, pq.Array(&p.Tags), &userID, &p.Created, &p.Modified)
-	switch {
-	case err == sql.ErrNoRows:
-		return nil, fmt.Errorf("No post with slug")
-	case err != nil:
+	if len(pages) > 0 {
+		return pages[0], nil
+	}
+
+	id, err := slugRedirect(ctx, "page", slug)
+	if err != nil {
 		return nil, fmt.Errorf("Error running get query: %+v", err)
-	default:
-		u, err := GetUser(ctx, userID)
-		if err != nil {
-			return nil, err
-		}
+	}
 
-		if u != nil {
-			p.User = *u
-		}
-		return &p, nil
+	if id == "" {
+		return nil, fmt.Errorf("No post with slug")
 	}
+
+	return GetPageByID(ctx, id)
 }
 
 // GetPages returns an array of all pages that exist.
 func GetPages(ctx context.Context) ([]*Page, error) {
-	rows, err := db.QueryContext(ctx, "SELECT id, slug, title, content, category, tags, user_id, created_at, modified_at FROM pages ORDER BY modified_at DESC")
+	return queryPages(ctx, "SELECT "+pageColumns+" FROM pages ORDER BY modified_at DESC")
+}
+
+// queryPages runs a query that selects pageColumns and returns the pages
+// found that the current user can see, with their users.
+func queryPages(ctx context.Context, query string, args ...interface{}) ([]*Page, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -140,13 +205,41 @@ func GetPages(ctx context.Context) ([]*Page, error) {
 	pages := make([]*Page, 0)
 	for rows.Next() {
 		var p Page
-		var userID string
-		err := rows.Scan(&p.ID, &p.Slug, &p.Title, &p.Content, &p.Category, pq.Array(&p.Tags), &userID, &p.Created, &p.Modified)
+		var editRole sql.NullString
+		err := rows.Scan(
+			&p.ID,
+			&p.Slug,
+			&p.Title,
+			&p.Content,
+			&p.Category,
+			pq.Array(&p.Tags),
+			&p.User.ID,
+			&p.Created,
+			&p.Modified,
+			&p.Owner.ID,
+			&p.Visibility,
+			&editRole,
+			pq.Array(&p.Editors),
+		)
 		if err != nil {
 			return nil, err
 		}
 
-		u, err := GetUser(ctx, userID)
+		if editRole.Valid {
+			r := Role(editRole.String)
+			p.EditRole = &r
+		}
+
+		pages = append(pages, &p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	pages = visiblePages(ctx, pages)
+	for _, p := range pages {
+		u, err := GetUser(ctx, p.User.ID)
 		if err != nil {
 			return nil, err
 		}
@@ -154,12 +247,7 @@ func GetPages(ctx context.Context) ([]*Page, error) {
 		if u != nil {
 			p.User = *u
 		}
-
-		pages = append(pages, &p)
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, err
-	}
 	return pages, nil
 }
@@ -0,0 +1,145 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+)
+
+// CanView reports whether the current user can see this page. Anyone who can
+// edit a page can see it, and admins can see everything.
+func (p *Page) CanView(ctx context.Context) bool {
+	u := GetUserFromContext(ctx)
+	switch p.Visibility {
+	case VisibilityPublic:
+		return true
+	case VisibilityLoggedIn:
+		return u != nil
+	default:
+		return p.CanEdit(ctx)
+	}
+}
+
+// CanEdit reports whether the current user can change this page. Its owner,
+// users with its edit role, users in its editors and admins can.
+func (p *Page) CanEdit(ctx context.Context) bool {
+	u := GetUserFromContext(ctx)
+	if u == nil {
+		return false
+	}
+
+	if Role(u.Role) == RoleAdmin || u.ID == p.Owner.ID {
+		return true
+	}
+
+	if p.EditRole != nil && Role(u.Role) == *p.EditRole {
+		return true
+	}
+
+	for _, id := range p.Editors {
+		if u.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Permissions describes who can see and change this page.
+func (p *Page) Permissions(ctx context.Context) (*PagePermissions, error) {
+	perms := &PagePermissions{
+		Visibility: p.Visibility,
+		EditRole:   p.EditRole,
+		Editors:    p.Editors,
+		CanView:    p.CanView(ctx),
+		CanEdit:    p.CanEdit(ctx),
+	}
+
+	if !p.Owner.Empty() {
+		owner, err := FindUser(ctx, p.Owner.ID)
+		if err != nil {
+			return nil, err
+		}
+		perms.Owner = owner
+	}
+
+	return perms, nil
+}
+
+// SetPermissions changes who can see and change this page, and saves it. Only
+// its owner and admins can change its permissions, and only admins can give
+// it a new owner.
+func (p *Page) SetPermissions(ctx context.Context, input EditPagePermissions) error {
+	u := GetUserFromContext(ctx)
+	if u == nil {
+		return fmt.Errorf("forbidden")
+	}
+
+	admin := Role(u.Role) == RoleAdmin
+	if !admin && u.ID != p.Owner.ID {
+		return fmt.Errorf("forbidden")
+	}
+
+	if input.Owner != nil {
+		if !admin {
+			return fmt.Errorf("forbidden")
+		}
+
+		owner, err := FindUser(ctx, *input.Owner)
+		if err != nil {
+			return err
+		}
+		p.Owner = *owner
+	}
+
+	if input.Visibility != nil {
+		p.Visibility = *input.Visibility
+	}
+
+	if input.EditRole != nil {
+		p.EditRole = input.EditRole
+	}
+
+	if input.ClearEditRole != nil && *input.ClearEditRole {
+		p.EditRole = nil
+	}
+
+	if input.Editors != nil {
+		p.Editors = input.Editors
+	}
+
+	return p.Save(ctx)
+}
+
+// pagesVisibleTo returns a SQL condition that limits pages to the ones the
+// current user can see, matching CanView. arg adds a query parameter and
+// returns its placeholder.
+func pagesVisibleTo(ctx context.Context, arg func(interface{}) string) string {
+	u := GetUserFromContext(ctx)
+	switch {
+	case u != nil && Role(u.Role) == RoleAdmin:
+		return "TRUE"
+	case u != nil:
+		id := arg(u.ID)
+		return fmt.Sprintf(
+			"(pages.visibility IN ('%s', '%s') OR pages.owner_id = %s OR %s = ANY(pages.editors) OR pages.edit_role = %s)",
+			VisibilityPublic,
+			VisibilityLoggedIn,
+			id,
+			id,
+			arg(u.Role))
+	default:
+		return fmt.Sprintf("pages.visibility = '%s'", VisibilityPublic)
+	}
+}
+
+// visiblePages returns the pages the current user can see.
+func visiblePages(ctx context.Context, pages []*Page) []*Page {
+	visible := make([]*Page, 0, len(pages))
+	for _, p := range pages {
+		if p.CanView(ctx) {
+			visible = append(visible, p)
+		}
+	}
+
+	return visible
+}
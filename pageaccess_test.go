@@ -0,0 +1,29 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSetPermissionsUnknownOwner(t *testing.T) {
+	testDB(t)
+
+	admin := testUser(t, RoleAdmin)
+	ctx := WithUser(context.Background(), admin)
+
+	p := &Page{Title: "Permissions " + uuid.New().String(), User: *admin}
+	if err := p.Save(ctx); err != nil {
+		t.Fatalf("Save: %+v", err)
+	}
+
+	id := "test-missing-" + uuid.New().String()
+	if err := p.SetPermissions(ctx, EditPagePermissions{Owner: &id}); err == nil {
+		t.Fatal("expected an error for a missing owner")
+	}
+
+	if _, err := FindUser(ctx, id); err == nil {
+		t.Error("SetPermissions created the missing user")
+	}
+}
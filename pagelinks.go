@@ -0,0 +1,167 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+
+	"github.com/lib/pq"
+)
+
+// HTML returns the page as rendered HTML, with wiki links turned into links
+// to pages.
+func (p *Page) HTML() template.HTML {
+	return WikiMarkdown(p.Content)
+}
+
+// saveLinks replaces the stored links from this page with the wiki links in
+// its content.
+func (p *Page) saveLinks(ctx context.Context, ex execer) error {
+	if _, err := ex.ExecContext(ctx, "DELETE FROM page_links WHERE page_id = $1", p.ID); err != nil {
+		return err
+	}
+
+	_, err := ex.ExecContext(
+		ctx,
+		`
+INSERT INTO page_links(page_id, target_slug)
+SELECT $1, UNNEST($2::text[])
+ON CONFLICT DO NOTHING;
+`,
+		p.ID,
+		pq.Array(WikiLinks(p.Content)))
+
+	return err
+}
+
+// backfillPageLinks saves the links of pages that have wiki links in their
+// content but none stored, like pages written before links were stored. It
+// runs when the database is set up, and does nothing once every page has its
+// links.
+func backfillPageLinks(ctx context.Context) error {
+	rows, err := db.QueryContext(ctx, `
+SELECT id, content FROM pages
+WHERE content LIKE '%[[%'
+  AND NOT EXISTS (SELECT 1 FROM page_links WHERE page_links.page_id = pages.id)`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	pages := []*Page{}
+	for rows.Next() {
+		p := &Page{}
+		if err := rows.Scan(&p.ID, &p.Content); err != nil {
+			return err
+		}
+		pages = append(pages, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for _, p := range pages {
+		if err := p.saveLinks(ctx, db); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Backlinks returns every page that links to this page, including by a slug
+// it used to have, most recently modified first.
+func (p *Page) Backlinks(ctx context.Context) ([]*Page, error) {
+	return queryPages(ctx, `
+SELECT `+pageColumns+`
+FROM pages
+WHERE id IN (
+  SELECT page_id FROM page_links
+  WHERE target_slug = $1
+    OR target_slug IN (SELECT slug FROM slug_redirects WHERE kind = 'page' AND target_id = $2)
+)
+ORDER BY modified_at DESC`, p.Slug, p.ID)
+}
+
+// OutgoingLinks returns every page this page links to, following renamed
+// pages and including ones that do not exist yet, ordered by slug.
+func (p *Page) OutgoingLinks(ctx context.Context) ([]*WikiLink, error) {
+	rows, err := db.QueryContext(ctx, "SELECT target_slug FROM page_links WHERE page_id = $1 ORDER BY target_slug ASC", p.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	links := make([]*WikiLink, 0)
+	for rows.Next() {
+		l := &WikiLink{}
+		if err := rows.Scan(&l.Slug); err != nil {
+			return nil, err
+		}
+		links = append(links, l)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	pages, err := queryPages(ctx, `
+SELECT `+pageColumns+`
+FROM pages
+WHERE slug IN (SELECT target_slug FROM page_links WHERE page_id = $1)`, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	bySlug := map[string]*Page{}
+	for _, pg := range pages {
+		bySlug[pg.Slug] = pg
+	}
+
+	for _, l := range links {
+		l.Page = bySlug[l.Slug]
+		if l.Page != nil {
+			continue
+		}
+
+		id, err := slugRedirect(ctx, "page", l.Slug)
+		if err != nil {
+			return nil, err
+		}
+
+		if id == "" {
+			continue
+		}
+
+		renamed, err := queryPages(ctx, "SELECT "+pageColumns+" FROM pages WHERE id = $1", id)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(renamed) > 0 {
+			l.Page = renamed[0]
+		}
+	}
+
+	return links, nil
+}
+
+// WantedPages returns the slugs that pages the current user can see link to
+// but no page has or had, with how many pages link to each, most wanted first.
+func WantedPages(ctx context.Context) ([]*Count, error) {
+	args := []interface{}{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	return queryCounts(ctx, `
+SELECT target_slug, COUNT(*) AS cnt
+FROM page_links
+WHERE page_id IN (SELECT id FROM pages WHERE `+pagesVisibleTo(ctx, arg)+`)
+  AND NOT EXISTS (SELECT 1 FROM pages WHERE pages.slug = page_links.target_slug)
+  AND NOT EXISTS (SELECT 1 FROM slug_redirects WHERE kind = 'page' AND slug_redirects.slug = page_links.target_slug)
+GROUP BY target_slug
+ORDER BY cnt DESC, target_slug ASC`, args...)
+}
@@ -0,0 +1,43 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestBackfillPageLinks(t *testing.T) {
+	testDB(t)
+
+	u := testUser(t, RoleAdmin)
+	ctx := WithUser(context.Background(), u)
+
+	target := "test-" + uuid.New().String()
+	p := &Page{
+		Title:   "Backfill " + uuid.New().String(),
+		Content: "See [[" + target + "]].",
+		User:    *u,
+	}
+
+	if err := p.Save(ctx); err != nil {
+		t.Fatalf("Save: %+v", err)
+	}
+
+	if _, err := db.ExecContext(ctx, "DELETE FROM page_links WHERE page_id = $1", p.ID); err != nil {
+		t.Fatalf("could not delete links: %+v", err)
+	}
+
+	if err := backfillPageLinks(ctx); err != nil {
+		t.Fatalf("backfillPageLinks: %+v", err)
+	}
+
+	links, err := p.OutgoingLinks(ctx)
+	if err != nil {
+		t.Fatalf("OutgoingLinks: %+v", err)
+	}
+
+	if len(links) != 1 || links[0].Slug != target {
+		t.Errorf("got %+v, want a link to %s", links, target)
+	}
+}
@@ -0,0 +1,305 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// pageRevisionColumns are the columns selected for every page revision query,
+// in the order queryPageRevisions expects them.
+const pageRevisionColumns = "id, page_id, COALESCE(slug, ''), COALESCE(title, ''), COALESCE(content, ''), COALESCE(category, ''), user_id, created_at"
+
+// PageRevision is a snapshot of a wiki page, saved every time the page is.
+type PageRevision struct {
+	ID       string    `json:"id"`
+	PageID   string    `json:"page_id"`
+	Slug     string    `json:"slug"`
+	Title    string    `json:"title"`
+	Content  string    `json:"content"`
+	Category string    `json:"category"`
+	User     User      `json:"user"`
+	Created  time.Time `json:"created"`
+}
+
+// saveRevision records the current state of a page as a new revision.
+func (p *Page) saveRevision(ctx context.Context, ex execer) error {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+
+	_, err = ex.ExecContext(
+		ctx,
+		`
+INSERT INTO page_revisions(id, page_id, slug, title, content, category, user_id, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+`,
+		id.String(),
+		p.ID,
+		p.Slug,
+		p.Title,
+		p.Content,
+		p.Category,
+		p.User.ID,
+		p.Modified)
+
+	return err
+}
+
+// Revisions returns every revision of this page, newest first.
+func (p *Page) Revisions(ctx context.Context) ([]*PageRevision, error) {
+	return queryPageRevisions(ctx, "SELECT "+pageRevisionColumns+" FROM page_revisions WHERE page_id = $1 ORDER BY created_at DESC", p.ID)
+}
+
+// Revision returns one revision of this page, or nil if the page has no
+// revision with that ID.
+func (p *Page) Revision(ctx context.Context, id string) (*PageRevision, error) {
+	revs, err := queryPageRevisions(ctx, "SELECT "+pageRevisionColumns+" FROM page_revisions WHERE page_id = $1 AND id = $2", p.ID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(revs) == 0 {
+		return nil, nil
+	}
+
+	return revs[0], nil
+}
+
+// GetPageRevision gets a page revision by ID from the database.
+func GetPageRevision(ctx context.Context, id string) (*PageRevision, error) {
+	revs, err := queryPageRevisions(ctx, "SELECT "+pageRevisionColumns+" FROM page_revisions WHERE id = $1", id)
+	if err != nil {
+		return nil, fmt.Errorf("Error running get query: %+v", err)
+	}
+
+	if len(revs) == 0 {
+		return nil, fmt.Errorf("No page revision %s", id)
+	}
+
+	return revs[0], nil
+}
+
+// Revert changes the page back to how it was at a revision, as edited by u.
+// Reverting saves the page, so it adds a new revision rather than removing
+// any.
+func (p *Page) Revert(ctx context.Context, revisionID string, u *User) error {
+	rev, err := p.Revision(ctx, revisionID)
+	if err != nil {
+		return err
+	}
+
+	if rev == nil {
+		return fmt.Errorf("page %s has no revision %s", p.ID, revisionID)
+	}
+
+	p.Slug = rev.Slug
+	p.Title = rev.Title
+	p.Content = rev.Content
+	p.Category = rev.Category
+	if u != nil {
+		p.User = *u
+	}
+
+	return p.Save(ctx)
+}
+
+// DiffPageRevisions compares the content of two revisions of the same page.
+func DiffPageRevisions(ctx context.Context, fromID, toID string) (*PageDiff, error) {
+	from, err := GetPageRevision(ctx, fromID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := GetPageRevision(ctx, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	if from.PageID != to.PageID {
+		return nil, fmt.Errorf("revisions %s and %s are of different pages", fromID, toID)
+	}
+
+	// GetPageByID errors if the current user cannot see the page, so neither
+	// revision is diffed for them.
+	if _, err := GetPageByID(ctx, from.PageID); err != nil {
+		return nil, err
+	}
+
+	lines := diffLines(from.Content, to.Content)
+	return &PageDiff{
+		From:    *from,
+		To:      *to,
+		Lines:   lines,
+		Unified: unifiedDiff(lines),
+	}, nil
+}
+
+// maxDiffCells limits the size of the table diffLines fills in, which is the
+// product of the number of lines that differ between the two texts.
+const maxDiffCells = 1 << 20
+
+// diffLines returns the line by line changes that turn a into b, using the
+// longest common subsequence of their lines. Lines the texts start and end
+// with are kept as they are. If what is left between them is too large to
+// compare, it is all deleted and then inserted.
+func diffLines(a, b string) []DiffLine {
+	as := splitLines(a)
+	bs := splitLines(b)
+
+	prefix := 0
+	for prefix < len(as) && prefix < len(bs) && as[prefix] == bs[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(as)-prefix && suffix < len(bs)-prefix && as[len(as)-1-suffix] == bs[len(bs)-1-suffix] {
+		suffix++
+	}
+
+	lines := []DiffLine{}
+	for _, l := range as[:prefix] {
+		lines = append(lines, DiffLine{Op: DiffOpEqual, Text: l})
+	}
+
+	lines = append(lines, diffMiddle(as[prefix:len(as)-suffix], bs[prefix:len(bs)-suffix])...)
+
+	for _, l := range as[len(as)-suffix:] {
+		lines = append(lines, DiffLine{Op: DiffOpEqual, Text: l})
+	}
+
+	return lines
+}
+
+// diffMiddle diffs the lines between the common start and end of two texts.
+func diffMiddle(as, bs []string) []DiffLine {
+	lines := []DiffLine{}
+	if (len(as)+1)*(len(bs)+1) > maxDiffCells {
+		for _, l := range as {
+			lines = append(lines, DiffLine{Op: DiffOpDelete, Text: l})
+		}
+
+		for _, l := range bs {
+			lines = append(lines, DiffLine{Op: DiffOpInsert, Text: l})
+		}
+
+		return lines
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of as[i:] and
+	// bs[j:].
+	lcs := make([][]int, len(as)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(bs)+1)
+	}
+
+	for i := len(as) - 1; i >= 0; i-- {
+		for j := len(bs) - 1; j >= 0; j-- {
+			switch {
+			case as[i] == bs[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(as) || j < len(bs) {
+		switch {
+		case i < len(as) && j < len(bs) && as[i] == bs[j]:
+			lines = append(lines, DiffLine{Op: DiffOpEqual, Text: as[i]})
+			i++
+			j++
+		case j < len(bs) && (i == len(as) || lcs[i][j+1] > lcs[i+1][j]):
+			lines = append(lines, DiffLine{Op: DiffOpInsert, Text: bs[j]})
+			j++
+		default:
+			lines = append(lines, DiffLine{Op: DiffOpDelete, Text: as[i]})
+			i++
+		}
+	}
+
+	return lines
+}
+
+// unifiedDiff renders diff lines with a " ", "+" or "-" prefix on each line.
+func unifiedDiff(lines []DiffLine) string {
+	prefixes := map[DiffOp]string{
+		DiffOpEqual:  " ",
+		DiffOpInsert: "+",
+		DiffOpDelete: "-",
+	}
+
+	var sb strings.Builder
+	for _, l := range lines {
+		sb.WriteString(prefixes[l.Op])
+		sb.WriteString(l.Text)
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// splitLines splits text into lines, without a trailing empty line.
+func splitLines(text string) []string {
+	if text == "" {
+		return []string{}
+	}
+
+	return strings.Split(strings.TrimSuffix(strings.Replace(text, "\r\n", "\n", -1), "\n"), "\n")
+}
+
+// queryPageRevisions runs a query that selects pageRevisionColumns and
+// returns the revisions found.
+func queryPageRevisions(ctx context.Context, query string, args ...interface{}) ([]*PageRevision, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revs := make([]*PageRevision, 0)
+	for rows.Next() {
+		r := &PageRevision{}
+		var userID sql.NullString
+		err := rows.Scan(&r.ID, &r.PageID, &r.Slug, &r.Title, &r.Content, &r.Category, &userID, &r.Created)
+		if err != nil {
+			return nil, err
+		}
+		r.User.ID = userID.String
+		revs = append(revs, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Most revisions of a page are by the same few people, so look each of
+	// them up once.
+	users := map[string]*User{}
+	for _, r := range revs {
+		if r.User.ID == "" {
+			continue
+		}
+
+		u, ok := users[r.User.ID]
+		if !ok {
+			u, err = FindUser(ctx, r.User.ID)
+			if err != nil {
+				return nil, err
+			}
+			users[r.User.ID] = u
+		}
+		r.User = *u
+	}
+
+	return revs, nil
+}
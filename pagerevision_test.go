@@ -0,0 +1,86 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestDiffLines(t *testing.T) {
+	got := unifiedDiff(diffLines("a\nb\nc\nd\n", "a\nc\nx\nd\n"))
+	want := " a\n-b\n c\n+x\n d\n"
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestDiffLinesLarge(t *testing.T) {
+	var a, b []string
+	for i := 0; i < 2000; i++ {
+		a = append(a, fmt.Sprintf("a%d", i))
+		b = append(b, fmt.Sprintf("b%d", i))
+	}
+
+	text := func(middle []string) string {
+		return "start\n" + strings.Join(middle, "\n") + "\nend\n"
+	}
+
+	lines := diffLines(text(a), text(b))
+	if len(lines) != 4002 {
+		t.Fatalf("got %d lines, want 4002", len(lines))
+	}
+
+	if lines[0].Op != DiffOpEqual || lines[len(lines)-1].Op != DiffOpEqual {
+		t.Errorf("common start and end were not kept: %+v, %+v", lines[0], lines[len(lines)-1])
+	}
+
+	if lines[1].Op != DiffOpDelete || lines[2001].Op != DiffOpInsert {
+		t.Errorf("got %+v, %+v, want the middle deleted then inserted", lines[1], lines[2001])
+	}
+}
+
+func TestRevisionsDoNotUpdateUsers(t *testing.T) {
+	testDB(t)
+	ctx := context.Background()
+
+	u := testUser(t, RoleNormal)
+	before, err := FindUser(ctx, u.ID)
+	if err != nil {
+		t.Fatalf("FindUser: %+v", err)
+	}
+
+	p := &Page{ID: uuid.New().String(), Slug: "revisions", User: *u, Modified: time.Now()}
+	for i := 0; i < 2; i++ {
+		if err := p.saveRevision(ctx, db); err != nil {
+			t.Fatalf("saveRevision: %+v", err)
+		}
+	}
+
+	revs, err := p.Revisions(ctx)
+	if err != nil {
+		t.Fatalf("Revisions: %+v", err)
+	}
+
+	if len(revs) != 2 {
+		t.Fatalf("got %d revisions, want 2", len(revs))
+	}
+
+	for _, r := range revs {
+		if r.User.ID != u.ID {
+			t.Errorf("revision by %q, want %q", r.User.ID, u.ID)
+		}
+	}
+
+	after, err := FindUser(ctx, u.ID)
+	if err != nil {
+		t.Fatalf("FindUser: %+v", err)
+	}
+
+	if !after.Modified.Equal(before.Modified) {
+		t.Errorf("listing revisions updated the user: modified %v, was %v", after.Modified, before.Modified)
+	}
+}
@@ -2,26 +2,99 @@ package graphql
 
 import (
 	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
+	"io/ioutil"
 	"mime"
+	"net/http"
+	"os"
+	"strconv"
 	"time"
 
-	"cloud.google.com/go/storage"
+	// Register the image formats we can process.
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+
 	"github.com/google/uuid"
+	"github.com/lib/pq"
+	_ "golang.org/x/image/webp"
 )
 
-const (
-	// StorageBucketName is the bucket name we are uploading to.
-	StorageBucketName = "icco-cloud"
+// photoColumns are the columns selected for every photo query, in the order
+// queryPhotos expects them.
+const photoColumns = `id, year, COALESCE(content_type, ''), COALESCE(user_id, ''), width, height, created_at, modified_at,
+  taken_at, COALESCE(camera_make, ''), COALESCE(camera_model, ''), ST_AsBinary(location), size, crc32c,
+  COALESCE(caption, ''), COALESCE(post_id::text, ''), COALESCE(upload_hash, '')`
+
+// DefaultPhotoMaxBytes is the largest photo that can be uploaded if
+// PHOTO_MAX_BYTES is not set.
+const DefaultPhotoMaxBytes = 20 << 20
+
+// DefaultPhotoMaxPixels is the most pixels a photo can have if
+// PHOTO_MAX_PIXELS is not set.
+const DefaultPhotoMaxPixels = 50 * 1000 * 1000
+
+// PhotoContentTypes are the types of image that can be uploaded, and the
+// extensions they are saved with.
+var PhotoContentTypes = map[string]string{
+	"image/gif":  ".gif",
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+var (
+	// ErrPhotoTooLarge is returned when an upload is larger than
+	// PhotoMaxBytes.
+	ErrPhotoTooLarge = errors.New("photo is too large")
+
+	// ErrPhotoType is returned when an upload is not one of
+	// PhotoContentTypes.
+	ErrPhotoType = errors.New("photo is not a supported type")
+
+	// ErrPhotoTooManyPixels is returned when an upload has more pixels than
+	// PhotoMaxPixels, which would take too much memory to decode.
+	ErrPhotoTooManyPixels = errors.New("photo has too many pixels")
+
+	// crc32cTable is the Castagnoli table, which is what Cloud Storage uses
+	// for its checksums.
+	crc32cTable = crc32.MakeTable(crc32.Castagnoli)
 )
 
-func configureStorage(ctx context.Context, bucketID string) (*storage.BucketHandle, error) {
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return nil, err
+// DuplicatePhotoError is returned when a user uploads a photo they have
+// already uploaded.
+type DuplicatePhotoError struct {
+	Photo *Photo
+}
+
+func (e *DuplicatePhotoError) Error() string {
+	return fmt.Sprintf("photo is a duplicate of %s", e.Photo.ID)
+}
+
+// PhotoMaxBytes returns the largest photo that can be uploaded, from the
+// PHOTO_MAX_BYTES environment variable.
+func PhotoMaxBytes() int64 {
+	if n, err := strconv.ParseInt(os.Getenv("PHOTO_MAX_BYTES"), 10, 64); err == nil && n > 0 {
+		return n
+	}
+
+	return DefaultPhotoMaxBytes
+}
+
+// PhotoMaxPixels returns the most pixels a photo can have, from the
+// PHOTO_MAX_PIXELS environment variable.
+func PhotoMaxPixels() int64 {
+	if n, err := strconv.ParseInt(os.Getenv("PHOTO_MAX_PIXELS"), 10, 64); err == nil && n > 0 {
+		return n
 	}
-	return client.Bucket(bucketID), nil
+
+	return DefaultPhotoMaxPixels
 }
 
 // Photo represents an uploaded photo
@@ -30,49 +103,219 @@ type Photo struct {
 	User        User   `json:"user"`
 	Year        int
 	ContentType string
+	Width       *int      `json:"width"`
+	Height      *int      `json:"height"`
 	Created     time.Time `json:"created"`
 	Modified    time.Time `json:"modified"`
+
+	// Size is the length of the stored file in bytes, and Checksum is its
+	// CRC32C.
+	Size     int64  `json:"size"`
+	Checksum uint32 `json:"checksum"`
+
+	// UploadHash is the SHA-256 of the file as it was uploaded, which is how
+	// duplicate uploads are found.
+	UploadHash string `json:"upload_hash"`
+
+	Caption string `json:"caption"`
+	PostID  string `json:"post_id"`
+
+	// Metadata is what we kept from the photo's EXIF when it was uploaded.
+	Metadata PhotoExif
 }
 
-// Upload save the photo to GCS, and also makes sure the record is saved to the
-// database.
+// Upload saves the photo to the current storage backend, and also makes sure
+// the record is saved to the database. The photo's ContentType is sniffed from
+// the upload, and it is rejected with ErrPhotoTooLarge, ErrPhotoTooManyPixels,
+// ErrPhotoType or a DuplicatePhotoError if it is too big, not an image we
+// accept, or already uploaded by the same user.
 func (p *Photo) Upload(ctx context.Context, f io.Reader) error {
-	err := p.Save(ctx)
+	max := PhotoMaxBytes()
+	data, err := ioutil.ReadAll(io.LimitReader(f, max+1))
 	if err != nil {
 		return err
 	}
 
-	stgClient, err := configureStorage(ctx, StorageBucketName)
+	if int64(len(data)) > max {
+		return ErrPhotoTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	if _, ok := PhotoContentTypes[contentType]; !ok {
+		log.WithField("content_type", contentType).Debug("rejected photo upload")
+		return ErrPhotoType
+	}
+	p.ContentType = contentType
+
+	hash := sha256.Sum256(data)
+	p.UploadHash = hex.EncodeToString(hash[:])
+
+	if err := p.checkDuplicate(ctx); err != nil {
+		return err
+	}
+
+	if p.ID == "" {
+		uuid, err := uuid.NewRandom()
+		if err != nil {
+			return err
+		}
+		p.ID = uuid.String()
+	}
+
+	if p.Year == 0 {
+		p.Year = time.Now().Year()
+	}
+
+	processed, err := processPhoto(ctx, p, data)
 	if err != nil {
 		return err
 	}
 
+	if processed.width > 0 && processed.height > 0 {
+		p.Width = &processed.width
+		p.Height = &processed.height
+	}
+	p.Metadata = *processed.exif
+
+	// The checksum is of what is stored, which is not the upload if its
+	// metadata was stripped.
+	p.Size = int64(len(processed.original))
+	p.Checksum = crc32.Checksum(processed.original, crc32cTable)
+
 	tctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	uploader := stgClient.Object(p.Path()).NewWriter(tctx)
-	uploader.ACL = []storage.ACLRule{{Entity: storage.AllUsers, Role: storage.RoleReader}}
-	uploader.ContentType = p.ContentType
-	uploader.CacheControl = "public, max-age=86400"
+	// The files are stored before the photo is recorded, so that it is never
+	// recorded without them. If either step fails, the stored files are
+	// removed, so a retry starts from nothing.
+	stored, err := p.storeFiles(tctx, processed.original, processed.variants)
+	if err != nil {
+		deleteFiles(ctx, stored)
+		return err
+	}
+
+	err = p.record(ctx, processed.variants)
+	if err != nil {
+		deleteFiles(ctx, stored)
+	}
+
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" && pqErr.Constraint == "photos_user_id_upload_hash_idx" {
+		// The same photo was uploaded at the same time, and the other upload
+		// was saved first.
+		if err := p.checkDuplicate(ctx); err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// storeFiles puts the photo and its variants in storage. It returns the paths
+// it stored, even if it fails part way, so they can be removed.
+func (p *Photo) storeFiles(ctx context.Context, original []byte, variants []*PhotoVariant) ([]string, error) {
+	stored := []string{}
+	if err := putFile(ctx, p.Path(), p.ContentType, original); err != nil {
+		return stored, err
+	}
+	stored = append(stored, p.Path())
+
+	for _, v := range variants {
+		if err := putFile(ctx, v.Path, v.ContentType, v.data); err != nil {
+			return stored, err
+		}
+		stored = append(stored, v.Path)
+	}
 
-	// Add a checksum.
-	//uploader.CRC32C = crc32.Checksum(file, crc32.MakeTable(crc32.Castagnoli))
-	//uploader.SendCRC32C = true
+	return stored, nil
+}
 
-	_, err = io.Copy(uploader, f)
+// deleteFiles removes the files of an upload that failed. The upload has
+// already failed, so errors are only logged.
+func deleteFiles(ctx context.Context, paths []string) {
+	for _, path := range paths {
+		if err := CurrentStorage().Delete(ctx, path); err != nil {
+			log.WithError(err).WithField("path", path).Warn("could not remove file of failed upload")
+		}
+	}
+}
+
+// record saves the photo and its variants to the database together.
+func (p *Photo) record(ctx context.Context, variants []*PhotoVariant) error {
+	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 
-	if err := uploader.Close(); err != nil {
+	if err := p.save(ctx, tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := p.saveVariants(ctx, tx, variants); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// checkDuplicate returns a DuplicatePhotoError if the photo's user has
+// already uploaded a photo with the same UploadHash.
+func (p *Photo) checkDuplicate(ctx context.Context) error {
+	existing, err := queryPhotos(ctx, "SELECT "+photoColumns+" FROM photos WHERE user_id = $1 AND upload_hash = $2 LIMIT 1", p.User.ID, p.UploadHash)
+	if err != nil {
 		return err
 	}
 
+	if len(existing) > 0 {
+		return &DuplicatePhotoError{Photo: existing[0]}
+	}
+
 	return nil
 }
 
+// Post returns the post the photo was uploaded for, if any.
+func (p *Photo) Post(ctx context.Context) (*Post, error) {
+	if p.PostID == "" {
+		return nil, nil
+	}
+
+	return GetPostString(ctx, p.PostID)
+}
+
+// CanAttach reports whether the current user can add this photo to albums,
+// posts and pages. Its owner and admins can.
+func (p *Photo) CanAttach(ctx context.Context) bool {
+	u := GetUserFromContext(ctx)
+	if u == nil {
+		return false
+	}
+
+	return Role(u.Role) == RoleAdmin || u.ID == p.User.ID
+}
+
+// Exif returns what we kept from the photo's EXIF, or nil if there was none.
+// Where the photo was taken is only returned to its owner and admins.
+func (p *Photo) Exif(ctx context.Context) *PhotoExif {
+	e := p.Metadata
+	if u := GetUserFromContext(ctx); u == nil || (u.ID != p.User.ID && Role(u.Role) != RoleAdmin) {
+		e.Location = nil
+	}
+
+	if e.empty() {
+		return nil
+	}
+
+	return &e
+}
+
 // Save adds the photo to the database and checks that no data is missing.
 func (p *Photo) Save(ctx context.Context) error {
+	return p.save(ctx, db)
+}
+
+// save fills in what is missing from the photo and writes it with ex.
+func (p *Photo) save(ctx context.Context, ex execer) error {
 	if p.ID == "" {
 		uuid, err := uuid.NewRandom()
 		if err != nil {
@@ -91,13 +334,25 @@ func (p *Photo) Save(ctx context.Context) error {
 
 	p.Modified = time.Now()
 
-	if _, err := db.ExecContext(
+	loc, err := GeoConvertValue(p.Metadata.Location)
+	if err != nil {
+		return err
+	}
+
+	// Photos uploaded before checksums were kept have none.
+	var checksum *int64
+	if p.Size > 0 {
+		c := int64(p.Checksum)
+		checksum = &c
+	}
+
+	if _, err := ex.ExecContext(
 		ctx,
 		`
-INSERT INTO photos(id, year, content_type, user_id, created_at, modified_at)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO photos(id, year, content_type, user_id, created_at, modified_at, width, height, taken_at, camera_make, camera_model, location, size, crc32c, caption, post_id, upload_hash)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NULLIF($10, ''), NULLIF($11, ''), ST_GeogFromWKB($12), NULLIF($13, 0), $14, NULLIF($15, ''), NULLIF($16, '')::bigint, NULLIF($17, ''))
 ON CONFLICT (id) DO UPDATE
-SET (year, content_type, user_id, created_at, modified_at) = ($2, $3, $4, $5, $6)
+SET (year, content_type, user_id, created_at, modified_at, width, height, taken_at, camera_make, camera_model, location, size, crc32c, caption, post_id, upload_hash) = ($2, $3, $4, $5, $6, $7, $8, $9, NULLIF($10, ''), NULLIF($11, ''), ST_GeogFromWKB($12), NULLIF($13, 0), $14, NULLIF($15, ''), NULLIF($16, '')::bigint, NULLIF($17, ''))
 WHERE photos.id = $1;
 `,
 		p.ID,
@@ -105,7 +360,18 @@ WHERE photos.id = $1;
 		p.ContentType,
 		p.User.ID,
 		p.Created,
-		p.Modified); err != nil {
+		p.Modified,
+		p.Width,
+		p.Height,
+		p.Metadata.Taken,
+		p.Metadata.CameraMake,
+		p.Metadata.CameraModel,
+		loc,
+		p.Size,
+		checksum,
+		p.Caption,
+		p.PostID,
+		p.UploadHash); err != nil {
 		return err
 	}
 
@@ -114,18 +380,141 @@ WHERE photos.id = $1;
 
 // Path returns the path the photo should be saved to.
 func (p *Photo) Path() string {
-	exts, err := mime.ExtensionsByType(p.ContentType)
-	ext := ""
-	if len(exts) > 0 && err == nil {
-		ext = exts[0]
-	} else {
-		log.WithError(err).Warn("couldn't get an extension")
+	ext, ok := PhotoContentTypes[p.ContentType]
+	if !ok {
+		// Photos uploaded before types were checked can be anything.
+		if exts, err := mime.ExtensionsByType(p.ContentType); err == nil && len(exts) > 0 {
+			ext = exts[0]
+		}
 	}
 
 	return fmt.Sprintf("photos/%d/%s%s", p.Year, p.ID, ext)
 }
 
-// URI returns the URI for this photo.
+// URI returns the URI for this photo in the current storage backend.
 func (p *Photo) URI() URI {
-	return NewURI(fmt.Sprintf("https://storage.googleapis.com/%s/%s", StorageBucketName, p.Path()))
+	return NewURI(CurrentStorage().URL(p.Path()))
+}
+
+// Delete removes the photo and its variants from the database and from
+// storage, and takes it out of any albums, posts and pages.
+func (p *Photo) Delete(ctx context.Context) error {
+	if err := p.deleteVariants(ctx); err != nil {
+		return err
+	}
+
+	if err := CurrentStorage().Delete(ctx, p.Path()); err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, "DELETE FROM photo_attachments WHERE photo_id = $1", p.ID); err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, "UPDATE albums SET cover_photo_id = NULL WHERE cover_photo_id = $1", p.ID); err != nil {
+		return err
+	}
+
+	_, err := db.ExecContext(ctx, "DELETE FROM photos WHERE id = $1", p.ID)
+	return err
+}
+
+// GetPhoto gets a photo by ID from the database.
+func GetPhoto(ctx context.Context, id string) (*Photo, error) {
+	photos, err := queryPhotos(ctx, "SELECT "+photoColumns+" FROM photos WHERE id = $1", id)
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("Error running get query: %+v", err)
+	case len(photos) == 0:
+		return nil, fmt.Errorf("No photo %s", id)
+	default:
+		return photos[0], nil
+	}
+}
+
+// GetPhotos returns photos, newest first.
+func GetPhotos(ctx context.Context, limit, offset int) ([]*Photo, error) {
+	return queryPhotos(ctx, "SELECT "+photoColumns+" FROM photos ORDER BY created_at DESC LIMIT $1 OFFSET $2", limit, offset)
+}
+
+// UserPhotos returns the photos a User uploaded, newest first.
+func UserPhotos(ctx context.Context, u *User, limit, offset int) ([]*Photo, error) {
+	if u == nil {
+		return nil, fmt.Errorf("no user specified")
+	}
+
+	return queryPhotos(ctx, "SELECT "+photoColumns+" FROM photos WHERE user_id = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3", u.ID, limit, offset)
+}
+
+// queryPhotos runs a query that selects photoColumns and returns the photos
+// found, with their users.
+func queryPhotos(ctx context.Context, query string, args ...interface{}) ([]*Photo, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	photos := make([]*Photo, 0)
+	for rows.Next() {
+		p := &Photo{}
+		var width, height, size, checksum sql.NullInt64
+		var loc []byte
+		err := rows.Scan(
+			&p.ID,
+			&p.Year,
+			&p.ContentType,
+			&p.User.ID,
+			&width,
+			&height,
+			&p.Created,
+			&p.Modified,
+			&p.Metadata.Taken,
+			&p.Metadata.CameraMake,
+			&p.Metadata.CameraModel,
+			&loc,
+			&size,
+			&checksum,
+			&p.Caption,
+			&p.PostID,
+			&p.UploadHash,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		p.Metadata.Location, err = GeoFromWKB(loc)
+		if err != nil {
+			return nil, err
+		}
+
+		if width.Valid && height.Valid {
+			w, h := int(width.Int64), int(height.Int64)
+			p.Width = &w
+			p.Height = &h
+		}
+
+		p.Size = size.Int64
+		p.Checksum = uint32(checksum.Int64)
+
+		photos = append(photos, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, p := range photos {
+		if p.User.ID == "" {
+			continue
+		}
+
+		u, err := FindUser(ctx, p.User.ID)
+		if err != nil {
+			return nil, err
+		}
+		p.User = *u
+	}
+
+	return photos, nil
 }
@@ -0,0 +1,193 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestPhotoExifLocation(t *testing.T) {
+	p := &Photo{
+		User:     User{ID: "owner"},
+		Metadata: PhotoExif{CameraMake: "Canon", Location: &Geo{Lat: 1, Long: 2}},
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want bool
+	}{
+		{"anonymous", context.Background(), false},
+		{"other user", WithUser(context.Background(), &User{ID: "other", Role: string(RoleNormal)}), false},
+		{"owner", WithUser(context.Background(), &User{ID: "owner", Role: string(RoleNormal)}), true},
+		{"admin", WithUser(context.Background(), &User{ID: "admin", Role: string(RoleAdmin)}), true},
+	}
+
+	for _, tc := range tests {
+		e := p.Exif(tc.ctx)
+		if e == nil || e.CameraMake != "Canon" {
+			t.Errorf("%s: Exif = %+v, want the camera", tc.name, e)
+			continue
+		}
+
+		if got := e.Location != nil; got != tc.want {
+			t.Errorf("%s: got location %v, want %v", tc.name, got, tc.want)
+		}
+	}
+
+	if p.Metadata.Location == nil {
+		t.Error("Exif cleared the photo's location")
+	}
+
+	p.Metadata = PhotoExif{Location: &Geo{Lat: 1, Long: 2}}
+	if e := p.Exif(context.Background()); e != nil {
+		t.Errorf("Exif = %+v, want nil when only the location was kept", e)
+	}
+}
+
+func TestPhotoDecodesWebP(t *testing.T) {
+	// A 1x1 lossless WebP.
+	data, err := base64.StdEncoding.DecodeString("UklGRhoAAABXRUJQVlA4TA0AAAAvAAAAEAcQERGIiP4HAA==")
+	if err != nil {
+		t.Fatalf("could not decode test image: %+v", err)
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("DecodeConfig: %+v", err)
+	}
+
+	if format != "webp" || cfg.Width != 1 || cfg.Height != 1 {
+		t.Errorf("got %s %dx%d, want webp 1x1", format, cfg.Width, cfg.Height)
+	}
+}
+
+func TestPhotoUploadDuplicate(t *testing.T) {
+	testDB(t)
+
+	dir, err := ioutil.TempDir("", "photos")
+	if err != nil {
+		t.Fatalf("TempDir: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	old := CurrentStorage()
+	SetStorage(NewLocalStorage(dir, "http://localhost:8080"))
+	defer SetStorage(old)
+
+	u := testUser(t, RoleNormal)
+	ctx := WithUser(context.Background(), u)
+
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, testImage(8, 8)); err != nil {
+		t.Fatalf("could not encode: %+v", err)
+	}
+
+	first := &Photo{User: *u}
+	if err := first.Upload(ctx, bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("Upload: %+v", err)
+	}
+
+	if first.UploadHash == "" || first.Size == 0 {
+		t.Errorf("got hash %q and size %d, want both set", first.UploadHash, first.Size)
+	}
+
+	second := &Photo{User: *u}
+	err = second.Upload(ctx, bytes.NewReader(buf.Bytes()))
+	dup, ok := err.(*DuplicatePhotoError)
+	if !ok {
+		t.Fatalf("got %+v, want a DuplicatePhotoError", err)
+	}
+
+	if dup.Photo.ID != first.ID {
+		t.Errorf("duplicate of %s, want %s", dup.Photo.ID, first.ID)
+	}
+}
+
+// failingStorage keeps files in memory, and fails every Put after the first
+// ok.
+type failingStorage struct {
+	noStorage
+	ok    int
+	files map[string]bool
+}
+
+func (s *failingStorage) Put(ctx context.Context, path, contentType string, r io.Reader) error {
+	if s.ok == 0 {
+		return errors.New("storage is down")
+	}
+
+	s.ok--
+	s.files[path] = true
+	return nil
+}
+
+func (s *failingStorage) Delete(ctx context.Context, path string) error {
+	delete(s.files, path)
+	return nil
+}
+
+func TestPhotoUploadStorageFailure(t *testing.T) {
+	testDB(t)
+
+	old := CurrentStorage()
+	defer SetStorage(old)
+
+	u := testUser(t, RoleNormal)
+	ctx := WithUser(context.Background(), u)
+
+	// Big enough to get a thumbnail, so there is more than one file.
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, testImage(300, 200)); err != nil {
+		t.Fatalf("could not encode: %+v", err)
+	}
+
+	for _, ok := range []int{0, 1} {
+		s := &failingStorage{ok: ok, files: map[string]bool{}}
+		SetStorage(s)
+
+		p := &Photo{User: *u}
+		if err := p.Upload(ctx, bytes.NewReader(buf.Bytes())); err == nil {
+			t.Fatalf("expected an error when storage fails after %d files", ok)
+		}
+
+		if len(s.files) != 0 {
+			t.Errorf("failing after %d files left %v", ok, s.files)
+		}
+
+		if _, err := GetPhoto(ctx, p.ID); err == nil {
+			t.Errorf("failing after %d files saved photo %s", ok, p.ID)
+		}
+	}
+
+	// Once storage works again, the same file can be uploaded.
+	s := &failingStorage{ok: 100, files: map[string]bool{}}
+	SetStorage(s)
+
+	p := &Photo{User: *u}
+	if err := p.Upload(ctx, bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("Upload: %+v", err)
+	}
+
+	variants, err := p.Variants(ctx)
+	if err != nil {
+		t.Fatalf("Variants: %+v", err)
+	}
+
+	if len(variants) == 0 {
+		t.Fatal("expected variants")
+	}
+
+	for _, path := range append([]string{p.Path()}, variants[0].Path) {
+		if !s.files[path] {
+			t.Errorf("%s was not stored", path)
+		}
+	}
+}
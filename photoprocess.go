@@ -0,0 +1,409 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rwcarlsen/goexif/exif"
+	"golang.org/x/image/draw"
+)
+
+// photoVariantSizes are the resized copies made of every photo, by the
+// longest side in pixels. Photos are never scaled up, so small photos do not
+// get the larger variants.
+var photoVariantSizes = []struct {
+	Name string
+	Size int
+}{
+	{"thumbnail", 200},
+	{"medium", 800},
+	{"large", 1600},
+}
+
+// photoJPEGQuality is the quality JPEGs are encoded at.
+const photoJPEGQuality = 85
+
+// PhotoVariant is a resized copy of a photo.
+type PhotoVariant struct {
+	PhotoID     string `json:"photo_id"`
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	Path        string `json:"path"`
+
+	data []byte
+}
+
+// URI returns the URI for this variant in the current storage backend.
+func (v *PhotoVariant) URI() URI {
+	return NewURI(CurrentStorage().URL(v.Path))
+}
+
+// PhotoExif is the metadata we keep from a photo's EXIF. Everything else,
+// including the original GPS tags, is stripped from the copies we store.
+type PhotoExif struct {
+	Taken       *time.Time `json:"taken"`
+	CameraMake  string     `json:"camera_make"`
+	CameraModel string     `json:"camera_model"`
+	Location    *Geo       `json:"location"`
+}
+
+// empty reports whether there is no metadata worth returning.
+func (e *PhotoExif) empty() bool {
+	return e.Taken == nil && e.CameraMake == "" && e.CameraModel == "" && e.Location == nil
+}
+
+// processedPhoto is the result of running an upload through the pipeline.
+type processedPhoto struct {
+	// original is what to store as the photo itself, which is the upload with
+	// its metadata stripped when we can.
+	original []byte
+	width    int
+	height   int
+	exif     *PhotoExif
+	variants []*PhotoVariant
+}
+
+// processPhoto decodes an uploaded photo, reads its EXIF, and makes its
+// resized variants. Images Go cannot decode are passed through untouched, and
+// images with more than PhotoMaxPixels are rejected before they are decoded.
+func processPhoto(ctx context.Context, p *Photo, data []byte) (*processedPhoto, error) {
+	out := &processedPhoto{original: data, exif: &PhotoExif{}}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		log.WithError(err).WithField("content_type", p.ContentType).Debug("could not decode photo, storing as is")
+		return out, nil
+	}
+
+	if int64(cfg.Width)*int64(cfg.Height) > PhotoMaxPixels() {
+		return nil, ErrPhotoTooManyPixels
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		log.WithError(err).WithField("content_type", p.ContentType).Debug("could not decode photo, storing as is")
+		return out, nil
+	}
+
+	orientation := 1
+	if x, err := exif.Decode(bytes.NewReader(data)); err == nil {
+		out.exif = readPhotoExif(x)
+		if tag, err := x.Get(exif.Orientation); err == nil {
+			if o, err := tag.Int(0); err == nil {
+				orientation = o
+			}
+		}
+	}
+
+	img = orientImage(img, orientation)
+	out.width = img.Bounds().Dx()
+	out.height = img.Bounds().Dy()
+
+	// Re-encoding a JPEG drops its EXIF, including where it was taken, and
+	// bakes in its orientation. Other formats rarely carry EXIF, and may be
+	// animated, so they are kept as uploaded.
+	if format == "jpeg" {
+		buf := &bytes.Buffer{}
+		if err := jpeg.Encode(buf, img, &jpeg.Options{Quality: 95}); err != nil {
+			return nil, err
+		}
+		out.original = buf.Bytes()
+	}
+
+	// Formats with transparency are kept lossless.
+	variantType := "image/jpeg"
+	if format == "png" || format == "gif" {
+		variantType = "image/png"
+	}
+
+	cwebp, _ := exec.LookPath("cwebp")
+	longest := out.width
+	if out.height > longest {
+		longest = out.height
+	}
+
+	for _, vs := range photoVariantSizes {
+		if vs.Size > longest {
+			continue
+		}
+
+		resized := resizeImage(img, vs.Size)
+		v, err := newPhotoVariant(p, vs.Name, variantType, resized)
+		if err != nil {
+			return nil, err
+		}
+		out.variants = append(out.variants, v)
+
+		if cwebp == "" {
+			continue
+		}
+
+		wv, err := newWebPVariant(ctx, cwebp, p, vs.Name+"-webp", resized)
+		if err != nil {
+			log.WithError(err).Warn("could not make webp variant")
+			continue
+		}
+		out.variants = append(out.variants, wv)
+	}
+
+	if cwebp == "" {
+		log.Debug("cwebp is not installed, not making webp variants")
+	}
+
+	return out, nil
+}
+
+// readPhotoExif pulls out the EXIF fields we keep.
+func readPhotoExif(x *exif.Exif) *PhotoExif {
+	e := &PhotoExif{}
+
+	if t, err := x.DateTime(); err == nil {
+		e.Taken = &t
+	}
+
+	if tag, err := x.Get(exif.Make); err == nil {
+		if s, err := tag.StringVal(); err == nil {
+			e.CameraMake = strings.TrimSpace(s)
+		}
+	}
+
+	if tag, err := x.Get(exif.Model); err == nil {
+		if s, err := tag.StringVal(); err == nil {
+			e.CameraModel = strings.TrimSpace(s)
+		}
+	}
+
+	if lat, long, err := x.LatLong(); err == nil && validLatLong(lat, long) == nil {
+		e.Location = &Geo{Lat: lat, Long: long}
+	}
+
+	return e
+}
+
+// orientImage rotates and flips an image so it is upright, given its EXIF
+// orientation.
+func orientImage(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if orientation >= 5 {
+		w, h = h, w
+	}
+
+	// Converting to RGBA uses draw's fast paths for decoded images, and lets
+	// pixels be moved by copying bytes.
+	src := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(src, src.Bounds(), img, b.Min, draw.Src)
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < b.Dy(); y++ {
+		for x := 0; x < b.Dx(); x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = b.Dx()-1-x, y
+			case 3:
+				dx, dy = b.Dx()-1-x, b.Dy()-1-y
+			case 4:
+				dx, dy = x, b.Dy()-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = b.Dy()-1-y, x
+			case 7:
+				dx, dy = b.Dy()-1-y, b.Dx()-1-x
+			case 8:
+				dx, dy = y, b.Dx()-1-x
+			}
+			si, di := src.PixOffset(x, y), dst.PixOffset(dx, dy)
+			copy(dst.Pix[di:di+4], src.Pix[si:si+4])
+		}
+	}
+
+	return dst
+}
+
+// resizeImage scales an image so its longest side is size pixels.
+func resizeImage(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	w, h := size, size
+	if b.Dx() >= b.Dy() {
+		h = b.Dy() * size / b.Dx()
+	} else {
+		w = b.Dx() * size / b.Dy()
+	}
+
+	if w < 1 {
+		w = 1
+	}
+
+	if h < 1 {
+		h = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, b, draw.Over, nil)
+	return dst
+}
+
+// newPhotoVariant encodes a resized image as a variant of p.
+func newPhotoVariant(p *Photo, name, contentType string, img image.Image) (*PhotoVariant, error) {
+	buf := &bytes.Buffer{}
+	ext := ".jpg"
+
+	switch contentType {
+	case "image/png":
+		ext = ".png"
+		if err := png.Encode(buf, img); err != nil {
+			return nil, err
+		}
+	default:
+		if err := jpeg.Encode(buf, img, &jpeg.Options{Quality: photoJPEGQuality}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &PhotoVariant{
+		PhotoID:     p.ID,
+		Name:        name,
+		ContentType: contentType,
+		Width:       img.Bounds().Dx(),
+		Height:      img.Bounds().Dy(),
+		Path:        p.variantPath(name, ext),
+		data:        buf.Bytes(),
+	}, nil
+}
+
+// newWebPVariant encodes a resized image as a WebP variant of p using the
+// cwebp tool, as Go cannot encode WebP itself.
+func newWebPVariant(ctx context.Context, cwebp string, p *Photo, name string, img image.Image) (*PhotoVariant, error) {
+	dir, err := ioutil.TempDir("", "photo-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	in := filepath.Join(dir, "in.png")
+	out := filepath.Join(dir, "out.webp")
+
+	f, err := os.Create(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, cwebp, "-quiet", "-q", fmt.Sprintf("%d", photoJPEGQuality), in, "-o", out)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("cwebp failed: %+v: %s", err, output)
+	}
+
+	data, err := ioutil.ReadFile(out)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PhotoVariant{
+		PhotoID:     p.ID,
+		Name:        name,
+		ContentType: "image/webp",
+		Width:       img.Bounds().Dx(),
+		Height:      img.Bounds().Dy(),
+		Path:        p.variantPath(name, ".webp"),
+		data:        data,
+	}, nil
+}
+
+// variantPath returns the path a variant of the photo should be saved to.
+func (p *Photo) variantPath(name, ext string) string {
+	return fmt.Sprintf("photos/%d/%s-%s%s", p.Year, p.ID, name, ext)
+}
+
+// saveVariants records the variants in the database. Their files must already
+// be stored.
+func (p *Photo) saveVariants(ctx context.Context, ex execer, variants []*PhotoVariant) error {
+	for _, v := range variants {
+		if _, err := ex.ExecContext(
+			ctx,
+			`
+INSERT INTO photo_variants(photo_id, name, content_type, width, height, path)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (photo_id, name) DO UPDATE
+SET (content_type, width, height, path) = ($3, $4, $5, $6)
+WHERE photo_variants.photo_id = $1 AND photo_variants.name = $2;
+`,
+			v.PhotoID,
+			v.Name,
+			v.ContentType,
+			v.Width,
+			v.Height,
+			v.Path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Variants returns the resized copies of this photo, smallest first.
+func (p *Photo) Variants(ctx context.Context) ([]*PhotoVariant, error) {
+	rows, err := db.QueryContext(ctx, "SELECT photo_id, name, content_type, width, height, path FROM photo_variants WHERE photo_id = $1 ORDER BY width ASC, name ASC", p.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	variants := make([]*PhotoVariant, 0)
+	for rows.Next() {
+		v := &PhotoVariant{}
+		if err := rows.Scan(&v.PhotoID, &v.Name, &v.ContentType, &v.Width, &v.Height, &v.Path); err != nil {
+			return nil, err
+		}
+		variants = append(variants, v)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return variants, nil
+}
+
+// deleteVariants removes the variants' files and records.
+func (p *Photo) deleteVariants(ctx context.Context) error {
+	variants, err := p.Variants(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range variants {
+		if err := CurrentStorage().Delete(ctx, v.Path); err != nil {
+			return err
+		}
+	}
+
+	_, err = db.ExecContext(ctx, "DELETE FROM photo_variants WHERE photo_id = $1", p.ID)
+	return err
+}
@@ -0,0 +1,89 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"testing"
+)
+
+// testImage returns a w by h image where every pixel is a different color.
+func testImage(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 255, A: 255})
+		}
+	}
+
+	return img
+}
+
+func TestOrientImage(t *testing.T) {
+	src := testImage(3, 2)
+
+	// want maps each orientation to where the pixel at (x, y) ends up.
+	want := map[int]func(x, y int) (int, int){
+		1: func(x, y int) (int, int) { return x, y },
+		2: func(x, y int) (int, int) { return 2 - x, y },
+		3: func(x, y int) (int, int) { return 2 - x, 1 - y },
+		4: func(x, y int) (int, int) { return x, 1 - y },
+		5: func(x, y int) (int, int) { return y, x },
+		6: func(x, y int) (int, int) { return 1 - y, x },
+		7: func(x, y int) (int, int) { return 1 - y, 2 - x },
+		8: func(x, y int) (int, int) { return y, 2 - x },
+	}
+
+	for orientation, move := range want {
+		got := orientImage(src, orientation)
+		for y := 0; y < 2; y++ {
+			for x := 0; x < 3; x++ {
+				dx, dy := move(x, y)
+				r1, g1, b1, a1 := src.At(x, y).RGBA()
+				r2, g2, b2, a2 := got.At(dx, dy).RGBA()
+				if r1 != r2 || g1 != g2 || b1 != b2 || a1 != a2 {
+					t.Errorf("orientation %d: pixel (%d, %d) did not move to (%d, %d)", orientation, x, y, dx, dy)
+				}
+			}
+		}
+	}
+}
+
+func TestProcessPhotoTooManyPixels(t *testing.T) {
+	old, ok := os.LookupEnv("PHOTO_MAX_PIXELS")
+	os.Setenv("PHOTO_MAX_PIXELS", "100")
+	defer func() {
+		if ok {
+			os.Setenv("PHOTO_MAX_PIXELS", old)
+		} else {
+			os.Unsetenv("PHOTO_MAX_PIXELS")
+		}
+	}()
+
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, testImage(20, 10)); err != nil {
+		t.Fatalf("could not encode: %+v", err)
+	}
+
+	p := &Photo{ID: "test", Year: 2019, ContentType: "image/png"}
+	if _, err := processPhoto(context.Background(), p, buf.Bytes()); err != ErrPhotoTooManyPixels {
+		t.Errorf("got %+v, want ErrPhotoTooManyPixels", err)
+	}
+
+	buf.Reset()
+	if err := png.Encode(buf, testImage(10, 10)); err != nil {
+		t.Fatalf("could not encode: %+v", err)
+	}
+
+	out, err := processPhoto(context.Background(), p, buf.Bytes())
+	if err != nil {
+		t.Fatalf("processPhoto: %+v", err)
+	}
+
+	if out.width != 10 || out.height != 10 {
+		t.Errorf("got %dx%d, want 10x10", out.width, out.height)
+	}
+}
@@ -15,9 +15,14 @@ import (
 	"github.com/lib/pq"
 )
 
+// postColumns are the columns selected for every post query, in the order
+// scanPost expects them.
+const postColumns = "id, title, content, date, created_at, modified_at, tags, draft, COALESCE(slug, '')"
+
 // Post is our representation of a post in the database.
 type Post struct {
 	ID       string    `json:"id"`
+	Slug     string    `json:"slug"`
 	Title    string    `json:"title"`
 	Content  string    `json:"content"`
 	Readtime int       `json:"readtime"`
@@ -40,7 +45,8 @@ func GetMaxID(ctx context.Context) (int64, error) {
 	return id, nil
 }
 
-// GetPostString gets a post by an ID string.
+// GetPostString gets a post by an ID string, or by slug if the string is not
+// an ID.
 func GetPostString(ctx context.Context, id string) (*Post, error) {
 	match, err := regexp.MatchString("^[0-9]+$", id)
 	if err != nil {
@@ -48,7 +54,7 @@ func GetPostString(ctx context.Context, id string) (*Post, error) {
 	}
 
 	if !match {
-		return nil, fmt.Errorf("No post with id %s", id)
+		return GetPostBySlug(ctx, id)
 	}
 
 	i, err := strconv.ParseInt(id, 10, 64)
@@ -62,8 +68,8 @@ func GetPostString(ctx context.Context, id string) (*Post, error) {
 // GetPost gets a post by ID from the database.
 func GetPost(ctx context.Context, id int64) (*Post, error) {
 	var post Post
-	row := db.QueryRowContext(ctx, "SELECT id, title, content, date, created_at, modified_at, tags, draft FROM posts WHERE id = $1", id)
-	err := row.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft)
+	row := db.QueryRowContext(ctx, "SELECT "+postColumns+" FROM posts WHERE id = $1", id)
+	err := scanPost(row, &post)
 	switch {
 	case err == sql.ErrNoRows:
 		return nil, nil
@@ -74,9 +80,42 @@ func GetPost(ctx context.Context, id int64) (*Post, error) {
 	}
 }
 
+// GetPostBySlug gets a post by slug from the database. If no post has the
+// slug, but a post used to, it returns that post.
+func GetPostBySlug(ctx context.Context, slug string) (*Post, error) {
+	var post Post
+	row := db.QueryRowContext(ctx, "SELECT "+postColumns+" FROM posts WHERE slug = $1", slug)
+	err := scanPost(row, &post)
+	switch {
+	case err == sql.ErrNoRows:
+		id, err := slugRedirect(ctx, "post", slug)
+		if err != nil {
+			return nil, fmt.Errorf("Error running get query: %+v", err)
+		}
+
+		if id == "" {
+			return nil, fmt.Errorf("No post with slug %s", slug)
+		}
+
+		return GetPostString(ctx, id)
+	case err != nil:
+		return nil, fmt.Errorf("Error running get query: %+v", err)
+	default:
+		return &post, nil
+	}
+}
+
+type postScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanPost(row postScanner, post *Post) error {
+	return row.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft, &post.Slug)
+}
+
 // AllPosts returns all posts from the database.
 func AllPosts(ctx context.Context, isDraft bool) ([]*Post, error) {
-	rows, err := db.QueryContext(ctx, "SELECT id, title, content, date, created_at, modified_at, tags, draft FROM posts WHERE draft = $1 ORDER BY date DESC", isDraft)
+	rows, err := db.QueryContext(ctx, "SELECT "+postColumns+" FROM posts WHERE draft = $1 ORDER BY date DESC", isDraft)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +124,7 @@ func AllPosts(ctx context.Context, isDraft bool) ([]*Post, error) {
 	posts := make([]*Post, 0)
 	for rows.Next() {
 		post := new(Post)
-		err := rows.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft)
+		err := scanPost(rows, post)
 		if err != nil {
 			return nil, err
 		}
@@ -190,13 +229,60 @@ func (p *Post) Save(ctx context.Context) error {
 
 	p.Modified = time.Now()
 
-	if _, err := db.ExecContext(
+	old, err := currentSlug(ctx, "post", p.ID)
+	if err != nil {
+		return err
+	}
+
+	// A post keeps the slug it has. Only posts without one get a slug from
+	// their title.
+	slug := p.Slug
+	switch {
+	case slug == "":
+		slug = postSlug(p.Title)
+	case slug != old:
+		slug = postSlug(slug)
+	}
+
+	return saveWithUniqueSlug(ctx, "post", p.ID, slug, func(slug string) error {
+		p.Slug = slug
+
+		// The post and the redirect from its old slug are saved together, so
+		// a renamed post is never left without one.
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		if err := p.save(ctx, tx, old); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		return tx.Commit()
+	})
+}
+
+// postSlug turns text into a slug for a post.
+func postSlug(text string) string {
+	// Slugs that are all digits would be mistaken for IDs.
+	slug := Slugify(text)
+	if _, err := strconv.ParseInt(slug, 10, 64); err == nil {
+		slug = "post-" + slug
+	}
+
+	return slug
+}
+
+// save writes the post and the redirect from its old slug.
+func (p *Post) save(ctx context.Context, ex execer, old string) error {
+	if _, err := ex.ExecContext(
 		ctx,
 		`
-INSERT INTO posts(id, title, content, date, draft, created_at, modified_at, tags)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO posts(id, title, content, date, draft, created_at, modified_at, tags, slug)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 ON CONFLICT (id) DO UPDATE
-SET (title, content, date, draft, created_at, modified_at, tags) = ($2, $3, $4, $5, $6, $7, $8)
+SET (title, content, date, draft, created_at, modified_at, tags, slug) = ($2, $3, $4, $5, $6, $7, $8, $9)
 WHERE posts.id = $1;
 `,
 		p.ID,
@@ -206,16 +292,12 @@ WHERE posts.id = $1;
 		p.Draft,
 		p.Created,
 		p.Modified,
-		pq.Array(p.Tags)); err != nil {
+		pq.Array(p.Tags),
+		p.Slug); err != nil {
 		return err
 	}
 
-	_, err = strconv.ParseInt(p.ID, 10, 64)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return recordSlugChange(ctx, ex, "post", p.ID, old, p.Slug)
 }
 
 // IntID returns this posts ID as an int.
@@ -238,8 +320,12 @@ func (p *Post) HTML() template.HTML {
 	return Markdown(p.Content)
 }
 
-// URI returns an absolute link to this post.
+// URI returns an absolute link to this post, using its slug if it has one.
 func (p *Post) URI() URI {
+	if p.Slug != "" {
+		return NewURI(fmt.Sprintf("https://writing.natwelch.com/post/%s", p.Slug))
+	}
+
 	return NewURI(fmt.Sprintf("https://writing.natwelch.com/post/%s", p.ID))
 }
 
@@ -359,7 +445,7 @@ func (p *Post) Related(ctx context.Context, input *Limit) ([]*Post, error) {
 
 // GetRandomPosts returns a random selection of posts.
 func GetRandomPosts(ctx context.Context, limit int, notIn []int64) ([]*Post, error) {
-	query := `SELECT id, title, content, date, created_at, modified_at, tags, draft
+	query := `SELECT ` + postColumns + `
   FROM posts
   WHERE draft = false
     AND id <> ALL($1)
@@ -374,7 +460,7 @@ func GetRandomPosts(ctx context.Context, limit int, notIn []int64) ([]*Post, err
 	posts := make([]*Post, 0)
 	for rows.Next() {
 		post := new(Post)
-		err := rows.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft)
+		err := scanPost(rows, post)
 		if err != nil {
 			return nil, err
 		}
@@ -391,7 +477,7 @@ func GetRandomPosts(ctx context.Context, limit int, notIn []int64) ([]*Post, err
 // Posts returns some posts.
 func Posts(ctx context.Context, limit int, offset int) ([]*Post, error) {
 	query := `
-SELECT id, title, content, date, created_at, modified_at, tags, draft
+SELECT ` + postColumns + `
 FROM posts
 WHERE draft = false
   AND date <= NOW()
@@ -407,7 +493,7 @@ LIMIT $1 OFFSET $2
 	posts := make([]*Post, 0)
 	for rows.Next() {
 		post := new(Post)
-		err := rows.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft)
+		err := scanPost(rows, post)
 		if err != nil {
 			return nil, err
 		}
@@ -422,7 +508,7 @@ LIMIT $1 OFFSET $2
 
 // PostsByTag returns all posts with a tag.
 func PostsByTag(ctx context.Context, tag string) ([]*Post, error) {
-	query := "SELECT id, title, content, date, created_at, modified_at, tags, draft FROM posts WHERE $1 = ANY(tags) and draft = false ORDER BY date DESC"
+	query := "SELECT " + postColumns + " FROM posts WHERE $1 = ANY(tags) and draft = false ORDER BY date DESC"
 	rows, err := db.QueryContext(ctx, query, tag)
 	if err != nil {
 		return nil, err
@@ -432,7 +518,7 @@ func PostsByTag(ctx context.Context, tag string) ([]*Post, error) {
 	posts := make([]*Post, 0)
 	for rows.Next() {
 		post := new(Post)
-		err := rows.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft)
+		err := scanPost(rows, post)
 		if err != nil {
 			return nil, err
 		}
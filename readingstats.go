@@ -0,0 +1,149 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+)
+
+// readFilter limits a query over books to ones finished in $1, or to all
+// finished books if $1 is 0.
+const readFilter = `shelf = 'read'
+  AND finished IS NOT NULL
+  AND ($1 = 0 OR EXTRACT(YEAR FROM finished AT TIME ZONE 'UTC') = $1)`
+
+// GetReadingStats summarizes the books we have finished. If year is nil, it
+// covers every book ever read and the challenge is for the current year.
+func GetReadingStats(ctx context.Context, year *int) (*ReadingStats, error) {
+	y := 0
+	if year != nil {
+		y = *year
+	}
+
+	stats := &ReadingStats{Year: year}
+
+	row := db.QueryRowContext(ctx, `SELECT COUNT(*), COALESCE(SUM(pages), 0), AVG(rating) FROM books WHERE `+readFilter, y)
+	var avg sql.NullFloat64
+	if err := row.Scan(&stats.BooksRead, &stats.PagesRead, &avg); err != nil {
+		return nil, err
+	}
+
+	if avg.Valid {
+		stats.AverageRating = &avg.Float64
+	}
+
+	var err error
+	stats.BooksPerYear, err = queryCounts(ctx, `
+SELECT to_char(finished AT TIME ZONE 'UTC', 'YYYY') AS year, COUNT(*) AS cnt
+FROM books
+WHERE shelf = 'read' AND finished IS NOT NULL
+GROUP BY year
+ORDER BY year ASC`)
+	if err != nil {
+		return nil, err
+	}
+
+	stats.BooksPerMonth, err = queryCounts(ctx, `
+SELECT to_char(finished AT TIME ZONE 'UTC', 'YYYY-MM') AS month, COUNT(*) AS cnt
+FROM books
+WHERE `+readFilter+`
+GROUP BY month
+ORDER BY month ASC`, y)
+	if err != nil {
+		return nil, err
+	}
+
+	stats.TopAuthors, err = queryCounts(ctx, `
+SELECT a AS author, COUNT(*) AS cnt
+FROM books, UNNEST(authors) AS a
+WHERE `+readFilter+`
+GROUP BY author
+ORDER BY cnt DESC, author ASC
+LIMIT 10`, y)
+	if err != nil {
+		return nil, err
+	}
+
+	if y == 0 {
+		y = time.Now().UTC().Year()
+	}
+
+	stats.Challenge, err = GetReadingChallenge(ctx, y)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// GetReadingChallenge returns progress towards the reading goal for a year, or
+// nil if no goal has been set.
+func GetReadingChallenge(ctx context.Context, year int) (*ReadingChallenge, error) {
+	c := &ReadingChallenge{Year: year}
+
+	row := db.QueryRowContext(ctx, "SELECT goal FROM reading_goals WHERE year = $1", year)
+	err := row.Scan(&c.Goal)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("Error running get query: %+v", err)
+	}
+
+	row = db.QueryRowContext(ctx, "SELECT COUNT(*) FROM books WHERE "+readFilter, year)
+	if err := row.Scan(&c.Read); err != nil {
+		return nil, err
+	}
+
+	c.track(time.Now().UTC())
+
+	return c, nil
+}
+
+// track works out how far through the challenge we are at now, and how that
+// compares to reading at an even pace through the year.
+func (c *ReadingChallenge) track(now time.Time) {
+	c.Progress = 0
+	if c.Goal > 0 {
+		c.Progress = float64(c.Read) / float64(c.Goal)
+	}
+
+	// Allow for float error, so that on the day a whole book is due it is
+	// expected rather than falling just short.
+	c.Expected = int(math.Floor(float64(c.Goal)*yearElapsed(c.Year, now) + 1e-9))
+	c.Ahead = c.Read - c.Expected
+}
+
+// SetReadingGoal sets how many books we want to read in a year.
+func SetReadingGoal(ctx context.Context, year, goal int) error {
+	if goal < 0 {
+		return fmt.Errorf("goal must not be negative")
+	}
+
+	_, err := db.ExecContext(ctx, `
+INSERT INTO reading_goals(year, goal, created_at, modified_at)
+VALUES ($1, $2, $3, $3)
+ON CONFLICT (year) DO UPDATE
+SET (goal, modified_at) = ($2, $3)
+WHERE reading_goals.year = $1;
+`, year, goal, time.Now())
+
+	return err
+}
+
+// yearElapsed returns how much of year has passed at now, from 0 to 1.
+func yearElapsed(year int, now time.Time) float64 {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+
+	switch {
+	case now.Before(start):
+		return 0
+	case !now.Before(end):
+		return 1
+	default:
+		return float64(now.Sub(start)) / float64(end.Sub(start))
+	}
+}
@@ -0,0 +1,145 @@
+package graphql
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestYearElapsed(t *testing.T) {
+	tests := []struct {
+		name string
+		year int
+		now  time.Time
+		want float64
+	}{
+		{"future year", 2020, day(2019, time.December, 31), 0},
+		{"start of year", 2020, day(2020, time.January, 1), 0},
+		{"past year", 2019, day(2020, time.June, 1), 1},
+		{"end of year", 2019, day(2020, time.January, 1), 1},
+		{"half way", 2019, time.Date(2019, time.July, 2, 12, 0, 0, 0, time.UTC), 0.5},
+		{"half way in a leap year", 2020, day(2020, time.July, 2), 0.5},
+		{"march", 2019, day(2019, time.March, 1), 59.0 / 365},
+		{"march in a leap year", 2020, day(2020, time.March, 1), 60.0 / 366},
+		{"other time zone", 2020, time.Date(2020, time.January, 1, 23, 0, 0, 0, time.FixedZone("PST", -8*60*60)), 31.0 / 24 / 366},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := yearElapsed(tc.year, tc.now); math.Abs(got-tc.want) > 1e-9 {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReadingChallengeTrack(t *testing.T) {
+	tests := []struct {
+		name     string
+		c        ReadingChallenge
+		now      time.Time
+		progress float64
+		expected int
+		ahead    int
+	}{
+		{"no goal", ReadingChallenge{Year: 2020, Goal: 0, Read: 3}, day(2020, time.July, 2), 0, 0, 3},
+		{"ahead", ReadingChallenge{Year: 2020, Goal: 52, Read: 30}, day(2020, time.July, 2), 30.0 / 52, 26, 4},
+		{"behind", ReadingChallenge{Year: 2020, Goal: 52, Read: 20}, day(2020, time.July, 2), 20.0 / 52, 26, -6},
+		{"past year", ReadingChallenge{Year: 2019, Goal: 52, Read: 40}, day(2020, time.July, 2), 40.0 / 52, 52, -12},
+		{"future year", ReadingChallenge{Year: 2021, Goal: 52, Read: 0}, day(2020, time.July, 2), 0, 0, 0},
+		{"goal beaten", ReadingChallenge{Year: 2019, Goal: 10, Read: 15}, day(2020, time.July, 2), 1.5, 10, 5},
+		{"book a day", ReadingChallenge{Year: 2019, Goal: 365, Read: 59}, day(2019, time.March, 1), 59.0 / 365, 59, 0},
+		{"book a day in a leap year", ReadingChallenge{Year: 2020, Goal: 366, Read: 60}, day(2020, time.March, 1), 60.0 / 366, 60, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := tc.c
+			c.track(tc.now)
+
+			if math.Abs(c.Progress-tc.progress) > 1e-9 {
+				t.Errorf("Progress = %v, want %v", c.Progress, tc.progress)
+			}
+
+			if c.Expected != tc.expected {
+				t.Errorf("Expected = %d, want %d", c.Expected, tc.expected)
+			}
+
+			if c.Ahead != tc.ahead {
+				t.Errorf("Ahead = %d, want %d", c.Ahead, tc.ahead)
+			}
+		})
+	}
+}
+
+func TestSetReadingGoal(t *testing.T) {
+	if err := SetReadingGoal(context.Background(), 2020, -1); err == nil {
+		t.Error("expected an error for a negative goal")
+	}
+}
+
+func TestReadingChallenge(t *testing.T) {
+	testDB(t)
+	ctx := context.Background()
+
+	// A year long past, so the challenge is over and nothing real is in it.
+	year := 1000 + rand.New(rand.NewSource(time.Now().UnixNano())).Intn(800)
+
+	before, err := GetReadingStats(ctx, &year)
+	if err != nil {
+		t.Fatalf("GetReadingStats: %+v", err)
+	}
+
+	finished := day(year, time.June, 1)
+	rating := 4
+	for _, b := range []*Book{
+		{Title: "read", Shelf: ShelfRead, Finished: &finished, Pages: 100, Rating: &rating},
+		{Title: "reading", Shelf: ShelfReading, Finished: &finished, Pages: 200},
+	} {
+		if err := b.Save(ctx); err != nil {
+			t.Fatalf("could not save book: %+v", err)
+		}
+	}
+
+	if err := SetReadingGoal(ctx, year, 10); err != nil {
+		t.Fatalf("SetReadingGoal: %+v", err)
+	}
+
+	// Setting the goal again updates it.
+	if err := SetReadingGoal(ctx, year, 20); err != nil {
+		t.Fatalf("SetReadingGoal: %+v", err)
+	}
+
+	stats, err := GetReadingStats(ctx, &year)
+	if err != nil {
+		t.Fatalf("GetReadingStats: %+v", err)
+	}
+
+	if stats.BooksRead != before.BooksRead+1 || stats.PagesRead != before.PagesRead+100 {
+		t.Errorf("got %d books and %d pages, want %d and %d", stats.BooksRead, stats.PagesRead, before.BooksRead+1, before.PagesRead+100)
+	}
+
+	c := stats.Challenge
+	if c == nil {
+		t.Fatal("expected a challenge")
+	}
+
+	if c.Year != year || c.Goal != 20 || c.Read != stats.BooksRead || c.Expected != 20 || c.Ahead != c.Read-20 {
+		t.Errorf("got challenge %+v", c)
+	}
+
+	none, err := GetReadingChallenge(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetReadingChallenge: %+v", err)
+	}
+
+	if none != nil {
+		t.Errorf("got %+v for a year without a goal", none)
+	}
+}
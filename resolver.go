@@ -4,10 +4,8 @@ package graphql
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"net/http"
+	"strings"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -56,13 +54,18 @@ func WithUser(ctx context.Context, u *User) context.Context {
 }
 
 // Resolver is the type that gqlgen expects to exist
-type Resolver struct{}
+type Resolver struct {
+	// Cacophony is used to look up URLs seen on the twitter home timeline.
+	Cacophony *CacophonyClient
+}
 
 // New returns a Config that has all of the proper settings for this graphql
 // server.
 func New() Config {
 	c := Config{
-		Resolvers: &Resolver{},
+		Resolvers: &Resolver{
+			Cacophony: DefaultCacophonyClient(),
+		},
 	}
 
 	c.Directives.HasRole = func(ctx context.Context, _ interface{}, next graphql.Resolver, role Role) (interface{}, error) {
@@ -95,11 +98,21 @@ func (r *Resolver) Mutation() MutationResolver {
 	return &mutationResolver{r}
 }
 
+// Page returns the resolver for the Page fields that need converting.
+func (r *Resolver) Page() PageResolver {
+	return &pageResolver{r}
+}
+
 // Query returns the resolver for Queries.
 func (r *Resolver) Query() QueryResolver {
 	return &queryResolver{r}
 }
 
+// User returns the resolver for the User fields only the user can see.
+func (r *Resolver) User() UserResolver {
+	return &userResolver{r}
+}
+
 // TwitterURL is a resolver factory to wrap the external twitter url type.
 func (r *Resolver) TwitterURL() TwitterURLResolver {
 	return &twitterURLResolver{r}
@@ -112,10 +125,19 @@ func (r *mutationResolver) CreatePost(ctx context.Context, input EditPost) (*Pos
 }
 
 func (r *mutationResolver) UpsertBook(ctx context.Context, input EditBook) (*Book, error) {
+	var err error
 	b := &Book{}
 
+	// We do this so the defaults in save don't overwrite stuff on upsert.
 	if input.ID != nil {
-		b.ID = *input.ID
+		b, err = findBook(ctx, *input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if b == nil {
+			b = &Book{ID: *input.ID}
+		}
 	}
 
 	if input.Title != nil {
@@ -124,10 +146,66 @@ func (r *mutationResolver) UpsertBook(ctx context.Context, input EditBook) (*Boo
 
 	b.GoodreadsID = input.GoodreadsID
 
-	err := b.Save(ctx)
+	if input.Authors != nil {
+		b.Authors = input.Authors
+	}
+
+	if input.Isbn != nil {
+		b.ISBN = *input.Isbn
+	}
+
+	if input.Shelf != nil {
+		b.Shelf = *input.Shelf
+	}
+
+	if input.Started != nil {
+		b.Started = input.Started
+	}
+
+	if input.Finished != nil {
+		b.Finished = input.Finished
+	}
+
+	if input.Rating != nil {
+		b.Rating = input.Rating
+	}
+
+	if input.ClearStarted != nil && *input.ClearStarted {
+		b.Started = nil
+	}
+
+	if input.ClearFinished != nil && *input.ClearFinished {
+		b.Finished = nil
+	}
+
+	if input.ClearRating != nil && *input.ClearRating {
+		b.Rating = nil
+	}
+
+	if input.Review != nil {
+		b.Review = *input.Review
+	}
+
+	if input.Pages != nil {
+		b.Pages = *input.Pages
+	}
+
+	err = b.Save(ctx)
 	return b, err
 }
 
+func (r *mutationResolver) ImportBooks(ctx context.Context, format BookImportFormat, data string) (int, error) {
+	return ImportBooks(ctx, format, strings.NewReader(data))
+}
+
+func (r *mutationResolver) SetReadingGoal(ctx context.Context, year int, goal int) (*ReadingChallenge, error) {
+	if err := SetReadingGoal(ctx, year, goal); err != nil {
+		return nil, err
+	}
+
+	return GetReadingChallenge(ctx, year)
+}
+
 func (r *mutationResolver) EditPost(ctx context.Context, input EditPost) (*Post, error) {
 	var err error
 	p := &Post{}
@@ -148,6 +226,10 @@ func (r *mutationResolver) EditPost(ctx context.Context, input EditPost) (*Post,
 		p.Title = *input.Title
 	}
 
+	if input.Slug != nil {
+		p.Slug = *input.Slug
+	}
+
 	if input.Content != nil {
 		p.Content = *input.Content
 	}
@@ -228,13 +310,262 @@ func (r *mutationResolver) InsertLog(ctx context.Context, input NewLog) (*Log, e
 	}
 
 	if input.Duration != nil {
-		l.Duration = ParseDurationFromString(*input.Duration)
+		d := ParseDurationFromString(*input.Duration)
+		l.Duration = &d
 	}
 
+	l.Visibility = input.Visibility
+
 	err := l.Save(ctx)
 	return l, err
 }
 
+func (r *mutationResolver) EditLog(ctx context.Context, input EditLog) (*Log, error) {
+	l, err := GetLog(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !l.CanEdit(ctx) {
+		return nil, fmt.Errorf("forbidden")
+	}
+
+	if input.Code != nil {
+		l.Code = *input.Code
+	}
+
+	if input.Datetime != nil {
+		l.Datetime = *input.Datetime
+	}
+
+	if input.Description != nil {
+		l.Description = *input.Description
+	}
+
+	if input.Location != nil {
+		l.Location = &Geo{
+			Lat:  input.Location.Lat,
+			Long: input.Location.Long,
+		}
+	}
+
+	if input.Project != nil {
+		l.Project = *input.Project
+	}
+
+	if input.Duration != nil {
+		d := ParseDurationFromString(*input.Duration)
+		l.Duration = &d
+	}
+
+	if input.Visibility != nil {
+		l.Visibility = input.Visibility
+	}
+
+	if input.ClearVisibility != nil && *input.ClearVisibility {
+		l.Visibility = nil
+	}
+
+	err = l.Save(ctx)
+	return l, err
+}
+
+func (r *mutationResolver) DeleteLog(ctx context.Context, id string) (bool, error) {
+	l, err := GetLog(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	if !l.CanEdit(ctx) {
+		return false, fmt.Errorf("forbidden")
+	}
+
+	if err := l.Delete(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) DeletePhoto(ctx context.Context, id string) (bool, error) {
+	p, err := GetPhoto(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	if err := p.Delete(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) UploadPhoto(ctx context.Context, file Upload, caption *string, postID *string) (*Photo, error) {
+	u := GetUserFromContext(ctx)
+	if u == nil {
+		return nil, fmt.Errorf("forbidden")
+	}
+
+	p := &Photo{User: *u}
+	if caption != nil {
+		p.Caption = *caption
+	}
+
+	if postID != nil {
+		// Only admins write posts, so only they can upload photos for them.
+		if Role(u.Role) != RoleAdmin {
+			return nil, fmt.Errorf("forbidden")
+		}
+
+		post, err := GetPostString(ctx, *postID)
+		if err != nil {
+			return nil, err
+		}
+
+		if post == nil {
+			return nil, fmt.Errorf("No post %s", *postID)
+		}
+		p.PostID = post.ID
+	}
+
+	f, _, err := file.Open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if err := p.Upload(ctx, f); err != nil {
+		return nil, err
+	}
+
+	if p.PostID != "" {
+		if err := attachPhoto(ctx, "post", p.PostID, p.ID); err != nil {
+			// Otherwise the photo is left behind, and uploading it again finds
+			// it as a duplicate.
+			if err := p.Delete(ctx); err != nil {
+				log.WithError(err).WithField("photo", p.ID).Error("could not remove photo that failed to attach")
+			}
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+func (r *mutationResolver) CreateAlbum(ctx context.Context, input NewAlbum) (*Album, error) {
+	u := GetUserFromContext(ctx)
+	if u == nil {
+		return nil, fmt.Errorf("forbidden")
+	}
+
+	a := &Album{
+		Title: input.Title,
+		User:  *u,
+	}
+
+	if input.Description != nil {
+		a.Description = *input.Description
+	}
+
+	if input.CoverPhotoID != nil {
+		a.CoverID = *input.CoverPhotoID
+		if err := checkAttachable(ctx, []string{a.CoverID}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := a.Create(ctx, input.PhotoIds); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (r *mutationResolver) SetAlbumPhotos(ctx context.Context, id string, photoIds []string) (*Album, error) {
+	a, err := GetAlbum(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.SetPhotos(ctx, photoIds); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (r *mutationResolver) SetPostPhotos(ctx context.Context, id string, photoIds []string) (*Post, error) {
+	p, err := GetPostString(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if p == nil {
+		return nil, fmt.Errorf("No post %s", id)
+	}
+
+	if err := p.SetPhotos(ctx, photoIds); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (r *mutationResolver) SetPagePhotos(ctx context.Context, id string, photoIds []string) (*Page, error) {
+	p, err := GetPageByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.SetPhotos(ctx, photoIds); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (r *mutationResolver) ResetFeedToken(ctx context.Context) (*User, error) {
+	u := GetUserFromContext(ctx)
+	if u == nil {
+		return nil, fmt.Errorf("forbidden")
+	}
+
+	err := u.ResetFeedToken(ctx)
+	return u, err
+}
+
+func (r *mutationResolver) SetLogProjectVisibility(ctx context.Context, project string, visibility Visibility) (bool, error) {
+	if err := SetLogProjectVisibility(ctx, GetUserFromContext(ctx), project, visibility); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) RevertPage(ctx context.Context, id string, revision string) (*Page, error) {
+	p, err := GetPageByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.CanEdit(ctx) {
+		return nil, fmt.Errorf("forbidden")
+	}
+
+	err = p.Revert(ctx, revision, GetUserFromContext(ctx))
+	return p, err
+}
+
+func (r *mutationResolver) SetPagePermissions(ctx context.Context, input EditPagePermissions) (*Page, error) {
+	p, err := GetPageByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.SetPermissions(ctx, input)
+	return p, err
+}
+
 func (r *mutationResolver) UpsertPage(ctx context.Context, input EditPage) (*Page, error) {
 	var err error
 	p := &Page{}
@@ -244,6 +575,10 @@ func (r *mutationResolver) UpsertPage(ctx context.Context, input EditPage) (*Pag
 		if err != nil {
 			return nil, err
 		}
+
+		if !p.CanEdit(ctx) {
+			return nil, fmt.Errorf("forbidden")
+		}
 	}
 
 	p.Content = input.Content
@@ -284,6 +619,22 @@ func (r *mutationResolver) UpsertTweet(ctx context.Context, input NewTweet) (*Tw
 		Urls:          input.Urls,
 	}
 
+	if input.InReplyToStatusID != nil {
+		t.InReplyToStatusID = *input.InReplyToStatusID
+	}
+
+	if input.InReplyToScreenName != nil {
+		t.InReplyToScreenName = *input.InReplyToScreenName
+	}
+
+	if input.QuotedStatusID != nil {
+		t.QuotedStatusID = *input.QuotedStatusID
+	}
+
+	if input.ConversationID != nil {
+		t.ConversationID = *input.ConversationID
+	}
+
 	err := t.Save(ctx)
 	if err != nil {
 		return nil, err
@@ -328,10 +679,14 @@ func (r *queryResolver) PrevPost(ctx context.Context, id string) (*Post, error)
 	return p.Prev(ctx)
 }
 
-func (r *queryResolver) Links(ctx context.Context, input *Limit) ([]*Link, error) {
+func (r *queryResolver) Links(ctx context.Context, filter *LinkFilter, input *Limit) ([]*Link, error) {
 	limit, offset := ParseLimit(input, 10, 0)
 
-	return GetLinks(ctx, limit, offset)
+	return FilterLinks(ctx, filter, limit, offset)
+}
+
+func (r *queryResolver) LinkTags(ctx context.Context) ([]*Count, error) {
+	return LinkTags(ctx)
 }
 
 func (r *queryResolver) Link(ctx context.Context, id *string, url *URI) (*Link, error) {
@@ -424,47 +779,147 @@ func (r *queryResolver) TweetsByScreenName(ctx context.Context, screenName strin
 	return GetTweetsByScreenName(ctx, screenName, limit, offset)
 }
 
+func (r *queryResolver) TweetsSearch(ctx context.Context, query *string, hashtag *string, mention *string, from *time.Time, to *time.Time, input *Limit) ([]*Tweet, error) {
+	limit, offset := ParseLimit(input, 10, 0)
+	return SearchTweets(ctx, query, hashtag, mention, from, to, limit, offset)
+}
+
+func (r *queryResolver) TopHashtags(ctx context.Context, screenName string, input *Limit) ([]*Count, error) {
+	limit, offset := ParseLimit(input, 10, 0)
+	return TopHashtags(ctx, screenName, limit, offset)
+}
+
+func (r *queryResolver) TopMentions(ctx context.Context, screenName string, input *Limit) ([]*Count, error) {
+	limit, offset := ParseLimit(input, 10, 0)
+	return TopMentions(ctx, screenName, limit, offset)
+}
+
+func (r *queryResolver) MostFavoritedTweets(ctx context.Context, screenName string, input *Limit) ([]*Tweet, error) {
+	limit, offset := ParseLimit(input, 10, 0)
+	return MostFavoritedTweets(ctx, screenName, limit, offset)
+}
+
+func (r *queryResolver) MostRetweetedTweets(ctx context.Context, screenName string, input *Limit) ([]*Tweet, error) {
+	limit, offset := ParseLimit(input, 10, 0)
+	return MostRetweetedTweets(ctx, screenName, limit, offset)
+}
+
+func (r *queryResolver) TweetsPerMonth(ctx context.Context, screenName string) ([]*Count, error) {
+	return TweetsPerMonth(ctx, screenName)
+}
+
+func (r *queryResolver) Books(ctx context.Context, shelf *Shelf, input *Limit) ([]*Book, error) {
+	limit, offset := ParseLimit(input, 10, 0)
+	return GetBooks(ctx, shelf, limit, offset)
+}
+
+func (r *queryResolver) Book(ctx context.Context, id string) (*Book, error) {
+	return GetBook(ctx, id)
+}
+
+func (r *queryResolver) Photos(ctx context.Context, input *Limit) ([]*Photo, error) {
+	limit, offset := ParseLimit(input, 10, 0)
+	return GetPhotos(ctx, limit, offset)
+}
+
+func (r *queryResolver) Photo(ctx context.Context, id string) (*Photo, error) {
+	return GetPhoto(ctx, id)
+}
+
+func (r *queryResolver) Albums(ctx context.Context, input *Limit) ([]*Album, error) {
+	limit, offset := ParseLimit(input, 10, 0)
+	return GetAlbums(ctx, limit, offset)
+}
+
+func (r *queryResolver) Album(ctx context.Context, id string) (*Album, error) {
+	return GetAlbum(ctx, id)
+}
+
+func (r *queryResolver) MyPhotos(ctx context.Context, input *Limit) ([]*Photo, error) {
+	limit, offset := ParseLimit(input, 10, 0)
+	return UserPhotos(ctx, GetUserFromContext(ctx), limit, offset)
+}
+
+func (r *queryResolver) ReadingStats(ctx context.Context, year *int) (*ReadingStats, error) {
+	return GetReadingStats(ctx, year)
+}
+
 func (r *queryResolver) HomeTimelineURLs(ctx context.Context, input *Limit) ([]*models.SavedURL, error) {
-	urls := []*models.SavedURL{}
 	limit, offset := ParseLimit(input, 100, 0)
 
-	url := fmt.Sprintf("https://cacophony.natwelch.com/?count=%d&offset=%d", limit, offset)
-	req, err := http.NewRequest("GET", url, nil)
+	return r.Cacophony.SavedURLs(ctx, limit, offset)
+}
+
+func (r *queryResolver) Tags(ctx context.Context) ([]string, error) {
+	return AllTags(ctx)
+}
+
+func (r *queryResolver) Logs(ctx context.Context, uid *string, filter *LogFilter, input *Limit) ([]*Log, error) {
+	u, err := logsUser(ctx, uid)
 	if err != nil {
-		return urls, err
+		return []*Log{}, err
 	}
 
-	req = req.WithContext(ctx)
-	client := http.DefaultClient
-	res, err := client.Do(req)
+	limit, offset := ParseLimit(input, 50, 0)
+	return FilterLogs(ctx, u, filter, limit, offset)
+}
+
+func (r *queryResolver) LogsNear(ctx context.Context, lat float64, long float64, radiusMeters float64, uid *string, input *Limit) ([]*Log, error) {
+	u, err := logsUser(ctx, uid)
 	if err != nil {
-		return urls, err
+		return []*Log{}, err
 	}
 
-	body, err := ioutil.ReadAll(res.Body)
+	limit, offset := ParseLimit(input, 50, 0)
+	return LogsNear(ctx, u, lat, long, radiusMeters, limit, offset)
+}
+
+func (r *queryResolver) LogsInBoundingBox(ctx context.Context, minLat float64, minLong float64, maxLat float64, maxLong float64, uid *string, input *Limit) ([]*Log, error) {
+	u, err := logsUser(ctx, uid)
 	if err != nil {
-		return urls, err
+		return []*Log{}, err
 	}
 
-	err = json.Unmarshal(body, &urls)
-	return urls, err
+	limit, offset := ParseLimit(input, 50, 0)
+	return LogsInBoundingBox(ctx, u, minLat, minLong, maxLat, maxLong, limit, offset)
 }
 
-func (r *queryResolver) Tags(ctx context.Context) ([]string, error) {
-	return AllTags(ctx)
+func (r *queryResolver) LogReport(ctx context.Context, from *time.Time, to *time.Time, groupBy []LogReportGroup, timezone *string) ([]*LogReportRow, error) {
+	tz := ""
+	if timezone != nil {
+		tz = *timezone
+	}
+
+	return GetLogReport(ctx, GetUserFromContext(ctx), from, to, groupBy, tz)
 }
 
-func (r *queryResolver) Logs(ctx context.Context, uid *string) ([]*Log, error) {
-	var err error
+func (r *queryResolver) LogProjects(ctx context.Context, uid *string) ([]*Count, error) {
+	u, err := logsUser(ctx, uid)
+	if err != nil {
+		return []*Count{}, err
+	}
+
+	return LogProjects(ctx, u)
+}
+
+func (r *queryResolver) LogCodes(ctx context.Context, uid *string) ([]*Count, error) {
+	u, err := logsUser(ctx, uid)
+	if err != nil {
+		return []*Count{}, err
+	}
+
+	return LogCodes(ctx, u)
+}
+
+// logsUser returns the user whose logs are being asked for, which is the
+// current user if uid is nil.
+func logsUser(ctx context.Context, uid *string) (*User, error) {
 	u := GetUserFromContext(ctx)
-	if uid != nil {
-		u, err = GetUser(ctx, *uid)
-		if err != nil {
-			return []*Log{}, err
-		}
+	if uid == nil || (u != nil && u.ID == *uid) {
+		return u, nil
 	}
 
-	return UserLogs(ctx, u)
+	return FindUser(ctx, *uid)
 }
 
 func (r *queryResolver) Time(ctx context.Context) (*time.Time, error) {
@@ -480,14 +935,49 @@ func (r *queryResolver) GetPageBySlug(ctx context.Context, slug string) (*Page,
 	return GetPageBySlug(ctx, slug)
 }
 
+func (r *queryResolver) Categories(ctx context.Context) ([]*Category, error) {
+	return GetCategories(ctx)
+}
+
+func (r *queryResolver) PagesByCategory(ctx context.Context, path string, recursive *bool) ([]*Page, error) {
+	return PagesByCategory(ctx, path, recursive != nil && *recursive)
+}
+
+func (r *queryResolver) WantedPages(ctx context.Context) ([]*Count, error) {
+	return WantedPages(ctx)
+}
+
+func (r *queryResolver) PageDiff(ctx context.Context, from string, to string) (*PageDiff, error) {
+	return DiffPageRevisions(ctx, from, to)
+}
+
 func (r *queryResolver) GetPages(ctx context.Context) ([]*Page, error) {
 	return GetPages(ctx)
 }
 
+type pageResolver struct{ *Resolver }
+
+func (r *pageResolver) HTML(ctx context.Context, obj *Page) (string, error) {
+	return string(obj.HTML()), nil
+}
+
 type twitterURLResolver struct{ *Resolver }
 
 func (r *twitterURLResolver) Link(ctx context.Context, obj *models.SavedURL) (*URI, error) {
-	return nil, fmt.Errorf("not implemented")
+	if obj.Link == "" {
+		return nil, nil
+	}
+
+	u := NewURI(obj.Link)
+	return &u, nil
+}
+
+func (r *twitterURLResolver) ArchivedLink(ctx context.Context, obj *models.SavedURL) (*Link, error) {
+	if obj.Link == "" {
+		return nil, nil
+	}
+
+	return getSavedLink(ctx, obj.Link)
 }
 
 func (r *twitterURLResolver) Tweets(ctx context.Context, obj *models.SavedURL) ([]*Tweet, error) {
@@ -499,3 +989,21 @@ func (r *twitterURLResolver) Tweets(ctx context.Context, obj *models.SavedURL) (
 
 	return tweets, nil
 }
+
+type userResolver struct{ *Resolver }
+
+func (r *userResolver) Apikey(ctx context.Context, obj *User) (*string, error) {
+	if !obj.isViewer(ctx) {
+		return nil, nil
+	}
+
+	return &obj.APIKey, nil
+}
+
+func (r *userResolver) FeedToken(ctx context.Context, obj *User) (*string, error) {
+	if !obj.isViewer(ctx) {
+		return nil, nil
+	}
+
+	return &obj.FeedToken, nil
+}
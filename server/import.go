@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/icco/graphql"
+)
+
+// runCommand runs a command line subcommand instead of starting the server.
+// It returns false if args do not name a known subcommand.
+func runCommand(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+
+	var err error
+	switch args[0] {
+	case "import-tweets":
+		err = importTweetsCommand(args[1:])
+	case "import-books":
+		err = importBooksCommand(args[1:])
+	default:
+		return false
+	}
+
+	if err != nil {
+		log.WithError(err).Fatalf("%s failed", args[0])
+	}
+
+	return true
+}
+
+// importTweetsCommand imports tweet.js files from a Twitter data export.
+//
+//	server import-tweets -screen_name icco tweet.js
+func importTweetsCommand(args []string) error {
+	fs := flag.NewFlagSet("import-tweets", flag.ExitOnError)
+	screenName := fs.String("screen_name", "", "screen name of the account the archive belongs to")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *screenName == "" || fs.NArg() == 0 {
+		return fmt.Errorf("usage: import-tweets -screen_name NAME FILE...")
+	}
+
+	for _, path := range fs.Args() {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+
+		n, err := graphql.ImportTweetArchive(context.Background(), f, *screenName)
+		f.Close()
+		if err != nil {
+			return err
+		}
+
+		log.WithField("file", path).Infof("imported %d tweets", n)
+	}
+
+	return nil
+}
+
+// importBooksCommand imports library exports from Goodreads or Open Library.
+//
+//	server import-books -format goodreads goodreads_library_export.csv
+func importBooksCommand(args []string) error {
+	fs := flag.NewFlagSet("import-books", flag.ExitOnError)
+	format := fs.String("format", string(graphql.BookImportFormatGoodreads), "format of the files: goodreads or openlibrary")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	f := graphql.BookImportFormat(*format)
+	if !f.IsValid() || fs.NArg() == 0 {
+		return fmt.Errorf("usage: import-books -format goodreads|openlibrary FILE...")
+	}
+
+	for _, path := range fs.Args() {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+
+		n, err := graphql.ImportBooks(context.Background(), f, file)
+		file.Close()
+		if err != nil {
+			return err
+		}
+
+		log.WithField("file", path).Infof("imported %d books", n)
+	}
+
+	return nil
+}
+
+func tweetImportHandler(w http.ResponseWriter, r *http.Request) {
+	u := graphql.GetUserFromContext(r.Context())
+	if u == nil || graphql.Role(u.Role) != graphql.RoleAdmin {
+		err := Renderer.JSON(w, http.StatusForbidden, map[string]string{
+			"error": "403: you must be an admin",
+		})
+		if err != nil {
+			log.WithError(err).Error("could not render json")
+		}
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err == http.ErrMissingFile {
+		err := Renderer.JSON(w, http.StatusBadRequest, map[string]string{
+			"error": "400: you must send a file",
+		})
+		if err != nil {
+			log.WithError(err).Error("could not render json")
+		}
+		return
+	} else if err != nil {
+		log.WithError(err).Error("error reading file upload")
+		internalErrorHandler(w, r)
+		return
+	}
+	defer file.Close()
+
+	screenName := r.FormValue("screen_name")
+	if screenName == "" {
+		err := Renderer.JSON(w, http.StatusBadRequest, map[string]string{
+			"error": "400: you must send a screen_name",
+		})
+		if err != nil {
+			log.WithError(err).Error("could not render json")
+		}
+		return
+	}
+
+	n, err := graphql.ImportTweetArchive(r.Context(), file, screenName)
+	if err != nil {
+		log.WithError(err).Error("could not import tweets")
+		internalErrorHandler(w, r)
+		return
+	}
+
+	err = Renderer.JSON(w, http.StatusOK, map[string]interface{}{
+		"import": "ok",
+		"tweets": n,
+	})
+	if err != nil {
+		log.WithError(err).Error("could not render json")
+	}
+}
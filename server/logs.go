@@ -0,0 +1,193 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/icco/graphql"
+)
+
+// logReportCSVHandler renders the same report as the logReport query as CSV,
+// for pasting into invoices. It takes from and to as RFC3339 times, group_by
+// as a comma separated list of LogReportGroups, and tz as an IANA timezone.
+func logReportCSVHandler(w http.ResponseWriter, r *http.Request) {
+	u := graphql.GetUserFromContext(r.Context())
+	if u == nil {
+		err := Renderer.JSON(w, http.StatusForbidden, map[string]string{
+			"error": "403: you must be logged in",
+		})
+		if err != nil {
+			log.WithError(err).Error("could not render json")
+		}
+		return
+	}
+
+	badRequest := func(msg string) {
+		err := Renderer.JSON(w, http.StatusBadRequest, map[string]string{
+			"error": "400: " + msg,
+		})
+		if err != nil {
+			log.WithError(err).Error("could not render json")
+		}
+	}
+
+	q := r.URL.Query()
+	var from, to *time.Time
+	for name, dst := range map[string]**time.Time{"from": &from, "to": &to} {
+		if v := q.Get(name); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				badRequest(fmt.Sprintf("%s must be an RFC3339 time", name))
+				return
+			}
+			*dst = &t
+		}
+	}
+
+	groupBy := []graphql.LogReportGroup{}
+	for _, g := range strings.Split(q.Get("group_by"), ",") {
+		if g = strings.TrimSpace(g); g == "" {
+			continue
+		}
+
+		group := graphql.LogReportGroup(strings.ToUpper(g))
+		if !group.IsValid() {
+			badRequest(fmt.Sprintf("%s is not a valid group", g))
+			return
+		}
+		groupBy = append(groupBy, group)
+	}
+
+	tz := q.Get("tz")
+	if _, err := time.LoadLocation(tz); err != nil {
+		badRequest(fmt.Sprintf("%q is not a valid timezone", tz))
+		return
+	}
+
+	report, err := graphql.GetLogReport(r.Context(), u, from, to, groupBy, tz)
+	if err != nil {
+		log.WithError(err).Error("could not get log report")
+		internalErrorHandler(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="logs.csv"`)
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"period", "project", "code", "hours", "count"}); err != nil {
+		log.WithError(err).Error("could not write csv")
+		return
+	}
+
+	for _, row := range report {
+		period := ""
+		if row.Period != nil {
+			period = row.Period.Format(time.RFC3339)
+		}
+
+		project := ""
+		if row.Project != nil {
+			project = *row.Project
+		}
+
+		code := ""
+		if row.Code != nil {
+			code = *row.Code
+		}
+
+		err := cw.Write([]string{
+			period,
+			project,
+			code,
+			fmt.Sprintf("%.2f", row.Duration.Seconds()/3600),
+			fmt.Sprintf("%d", row.Count),
+		})
+		if err != nil {
+			log.WithError(err).Error("could not write csv")
+			return
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		log.WithError(err).Error("could not write csv")
+	}
+}
+
+// logsGeoJSONHandler renders every log with a location as a GeoJSON
+// FeatureCollection, for putting on a map.
+func logsGeoJSONHandler(w http.ResponseWriter, r *http.Request) {
+	u := graphql.GetUserFromContext(r.Context())
+	if u == nil {
+		err := Renderer.JSON(w, http.StatusForbidden, map[string]string{
+			"error": "403: you must be logged in",
+		})
+		if err != nil {
+			log.WithError(err).Error("could not render json")
+		}
+		return
+	}
+
+	logs, err := graphql.UserLogs(r.Context(), u)
+	if err != nil {
+		log.WithError(err).Error("could not get logs")
+		internalErrorHandler(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/geo+json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(graphql.LogsGeoJSON(logs))
+	if err != nil {
+		log.WithError(err).Error("could not render json")
+	}
+}
+
+// logsICalendarHandler renders every log as an iCalendar feed. Calendar apps
+// cannot send auth headers, so as well as the usual auth it accepts a token
+// query parameter, which is either the user's feed token or API key.
+func logsICalendarHandler(w http.ResponseWriter, r *http.Request) {
+	u := graphql.GetUserFromContext(r.Context())
+	if token := r.URL.Query().Get("token"); token != "" {
+		u = nil
+		if _, err := uuid.Parse(token); err == nil {
+			u, err = graphql.GetUserByFeedToken(r.Context(), token)
+			if err != nil {
+				u, err = graphql.GetUserByAPIKey(r.Context(), token)
+			}
+
+			if err != nil {
+				log.WithError(err).Error("could not get user by feed token")
+			}
+		}
+	}
+
+	if u == nil {
+		err := Renderer.JSON(w, http.StatusForbidden, map[string]string{
+			"error": "403: you must be logged in or give a valid token",
+		})
+		if err != nil {
+			log.WithError(err).Error("could not render json")
+		}
+		return
+	}
+
+	ctx := graphql.WithUser(r.Context(), u)
+	logs, err := graphql.UserLogs(ctx, u)
+	if err != nil {
+		log.WithError(err).Error("could not get logs")
+		internalErrorHandler(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="logs.ics"`)
+	if err := graphql.WriteLogsICalendar(w, logs); err != nil {
+		log.WithError(err).Error("could not write calendar")
+	}
+}
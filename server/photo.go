@@ -1,49 +1,78 @@
 package main
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/icco/graphql"
 )
 
+// photoUploadOverhead is how much larger than the biggest photo an upload
+// request can be, to leave room for the rest of the multipart form.
+const photoUploadOverhead = 1 << 20
+
+// isBodyTooLarge reports whether err is from reading more of a request than
+// http.MaxBytesReader allows, which has no error value of its own to compare
+// against.
+func isBodyTooLarge(err error) bool {
+	return err != nil && err.Error() == "http: request body too large"
+}
+
 func photoUploadHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	u := graphql.GetUserFromContext(r.Context())
 	if u == nil {
-		err := Renderer.JSON(w, http.StatusForbidden, map[string]string{
-			"error": "403: you must be logged in",
-		})
-		if err != nil {
-			log.WithError(err).Error("could not render json")
-		}
+		photoUploadError(w, http.StatusForbidden, "not_logged_in", "you must be logged in", nil)
 		return
 	}
 
+	max := graphql.PhotoMaxBytes()
+	r.Body = http.MaxBytesReader(w, r.Body, max+photoUploadOverhead)
+
 	file, header, err := r.FormFile("file")
 	if err == http.ErrMissingFile {
-		err := Renderer.JSON(w, http.StatusBadRequest, map[string]string{
-			"error": "400: you must send a file",
-		})
-		if err != nil {
-			log.WithError(err).Error("could not render json")
-		}
+		photoUploadError(w, http.StatusBadRequest, "missing_file", "you must send a file", nil)
+		return
+	} else if isBodyTooLarge(err) {
+		photoUploadError(w, http.StatusRequestEntityTooLarge, "too_large", fmt.Sprintf("photos must be at most %d bytes", max), nil)
 		return
 	} else if err != nil {
 		log.WithError(err).Error("error reading file upload")
-		internalErrorHandler(w, r)
+		photoUploadError(w, http.StatusBadRequest, "bad_request", "could not read the upload", nil)
 		return
 	}
+	defer r.MultipartForm.RemoveAll()
 	defer file.Close()
 
 	log.WithField("file_header", header).Debug("recieved file")
 
 	p := &graphql.Photo{
-		ContentType: header.Header.Get("Content-Type"),
-		User:        *u,
+		User: *u,
 	}
 
 	err = p.Upload(ctx, file)
-	if err != nil {
+	if dup, ok := err.(*graphql.DuplicatePhotoError); ok {
+		f := dup.Photo.URI()
+		photoUploadError(w, http.StatusConflict, "duplicate", "you have already uploaded this photo", map[string]string{
+			"id":   dup.Photo.ID,
+			"file": (&f).String(),
+		})
+		return
+	}
+
+	switch err {
+	case nil:
+	case graphql.ErrPhotoTooLarge:
+		photoUploadError(w, http.StatusRequestEntityTooLarge, "too_large", fmt.Sprintf("photos must be at most %d bytes", max), nil)
+		return
+	case graphql.ErrPhotoTooManyPixels:
+		photoUploadError(w, http.StatusRequestEntityTooLarge, "too_many_pixels", fmt.Sprintf("photos must have at most %d pixels", graphql.PhotoMaxPixels()), nil)
+		return
+	case graphql.ErrPhotoType:
+		photoUploadError(w, http.StatusUnsupportedMediaType, "unsupported_type", "photos must be a gif, jpeg, png or webp", nil)
+		return
+	default:
 		log.WithError(err).Error("could not save image")
 		internalErrorHandler(w, r)
 		return
@@ -52,9 +81,47 @@ func photoUploadHandler(w http.ResponseWriter, r *http.Request) {
 	f := p.URI()
 	err = Renderer.JSON(w, http.StatusOK, map[string]string{
 		"upload": "ok",
+		"id":     p.ID,
 		"file":   (&f).String(),
 	})
 	if err != nil {
 		log.WithError(err).Error("could not render json")
 	}
 }
+
+// photoUploadError renders an upload failure. code is a stable name for the
+// kind of failure that clients can check, and extra adds fields to the
+// response.
+func photoUploadError(w http.ResponseWriter, status int, code, message string, extra map[string]string) {
+	resp := map[string]string{
+		"error": fmt.Sprintf("%d: %s", status, message),
+		"code":  code,
+	}
+
+	for k, v := range extra {
+		resp[k] = v
+	}
+
+	if err := Renderer.JSON(w, status, resp); err != nil {
+		log.WithError(err).Error("could not render json")
+	}
+}
+
+// localPhotosHandler serves uploaded files when they are kept in a local
+// directory, which is only done in development.
+func localPhotosHandler(w http.ResponseWriter, r *http.Request) {
+	ls, ok := graphql.CurrentStorage().(*graphql.LocalStorage)
+	if !ok {
+		notFoundHandler(w, r)
+		return
+	}
+
+	f, fi, err := ls.Open(strings.TrimPrefix(r.URL.Path, "/"))
+	if err != nil {
+		notFoundHandler(w, r)
+		return
+	}
+	defer f.Close()
+
+	http.ServeContent(w, r, fi.Name(), fi.ModTime(), f)
+}
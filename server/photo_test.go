@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/icco/graphql"
+)
+
+func TestLocalPhotosHandler(t *testing.T) {
+	dir, err := ioutil.TempDir("", "photos")
+	if err != nil {
+		t.Fatalf("TempDir: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := graphql.NewLocalStorage(dir, "http://localhost:8080")
+	if err := s.Put(context.Background(), "photos/2019/a.jpg", "image/jpeg", strings.NewReader("jpeg")); err != nil {
+		t.Fatalf("Put: %+v", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "photos", "2019", ".upload-123"), []byte("partial"), 0644); err != nil {
+		t.Fatalf("WriteFile: %+v", err)
+	}
+
+	old := graphql.CurrentStorage()
+	graphql.SetStorage(s)
+	defer graphql.SetStorage(old)
+
+	tests := map[string]int{
+		"/photos/2019/a.jpg":       http.StatusOK,
+		"/photos/2019/":            http.StatusNotFound,
+		"/photos/2019":             http.StatusNotFound,
+		"/photos/2019/.upload-123": http.StatusNotFound,
+		"/photos/2019/missing.jpg": http.StatusNotFound,
+		"/photos/../../etc/passwd": http.StatusNotFound,
+	}
+
+	for path, want := range tests {
+		w := httptest.NewRecorder()
+		localPhotosHandler(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		if w.Code != want {
+			t.Errorf("GET %s = %d, want %d", path, w.Code, want)
+		}
+
+		if want == http.StatusOK && w.Body.String() != "jpeg" {
+			t.Errorf("GET %s = %q, want the file", path, w.Body.String())
+		}
+	}
+}
+
+func TestIsBodyTooLarge(t *testing.T) {
+	w := httptest.NewRecorder()
+	body := http.MaxBytesReader(w, ioutil.NopCloser(strings.NewReader("too long")), 3)
+
+	_, err := ioutil.ReadAll(body)
+	if !isBodyTooLarge(err) {
+		t.Errorf("isBodyTooLarge(%+v) = false", err)
+	}
+
+	if isBodyTooLarge(nil) || isBodyTooLarge(errors.New("other")) {
+		t.Error("isBodyTooLarge matched other errors")
+	}
+}
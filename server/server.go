@@ -58,6 +58,16 @@ func main() {
 		log.Fatalf("Init DB: %+v", err)
 	}
 
+	store, err := graphql.NewStorageFromEnv()
+	if err != nil {
+		log.Fatalf("Init storage: %+v", err)
+	}
+	graphql.SetStorage(store)
+
+	if runCommand(os.Args[1:]) {
+		return
+	}
+
 	port := "8080"
 	if fromEnv := os.Getenv("PORT"); fromEnv != "" {
 		port = fromEnv
@@ -123,6 +133,7 @@ func main() {
 		}).Handler)
 
 		r.Get("/healthz", healthCheckHandler)
+		r.Get("/photos/*", localPhotosHandler)
 		r.Options("/photo/new", func(w http.ResponseWriter, r *http.Request) {
 			w.Write([]byte(""))
 		})
@@ -148,7 +159,7 @@ func main() {
 
 		r.Get("/cron", cronHandler)
 		r.Handle("/", handler.Playground("graphql", "/graphql"))
-		r.Handle("/graphql", handler.GraphQL(
+		r.With(graphqlUploadMiddleware).Handle("/graphql", handler.GraphQL(
 			graphql.NewExecutableSchema(graphql.New()),
 			handler.RecoverFunc(func(ctx context.Context, intErr interface{}) error {
 				err, ok := intErr.(error)
@@ -165,6 +176,10 @@ func main() {
 		))
 
 		r.Post("/photo/new", photoUploadHandler)
+		r.Post("/admin/tweets/import", tweetImportHandler)
+		r.Get("/logs/report.csv", logReportCSVHandler)
+		r.Get("/logs.geojson", logsGeoJSONHandler)
+		r.Get("/logs.ics", logsICalendarHandler)
 	})
 
 	h := &ochttp.Handler{
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/icco/graphql"
+)
+
+// graphqlUploadMiddleware turns GraphQL multipart requests, as described by
+// https://github.com/jaydenseric/graphql-multipart-request-spec, into normal
+// JSON requests. Each file's variable is set to its name in the request's map,
+// and the files are kept in the request's context for graphql.Upload to open.
+// Only logged in users can send files, so nothing is read from anyone else.
+func graphqlUploadMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if r.Method != http.MethodPost || err != nil || mediaType != "multipart/form-data" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if graphql.GetUserFromContext(r.Context()) == nil {
+			graphqlUploadError(w, http.StatusForbidden, "you must be logged in to upload files")
+			return
+		}
+
+		max := graphql.PhotoMaxBytes()
+		r.Body = http.MaxBytesReader(w, r.Body, max+photoUploadOverhead)
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			if isBodyTooLarge(err) {
+				graphqlUploadError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("uploads must be at most %d bytes", max))
+				return
+			}
+			graphqlUploadError(w, http.StatusBadRequest, "could not read multipart request")
+			return
+		}
+		defer r.MultipartForm.RemoveAll()
+
+		var operations map[string]interface{}
+		if err := json.Unmarshal([]byte(r.FormValue("operations")), &operations); err != nil {
+			graphqlUploadError(w, http.StatusBadRequest, "operations must be a JSON object")
+			return
+		}
+
+		var fileMap map[string][]string
+		if err := json.Unmarshal([]byte(r.FormValue("map")), &fileMap); err != nil {
+			graphqlUploadError(w, http.StatusBadRequest, "map must be a JSON object")
+			return
+		}
+
+		files := map[string]*multipart.FileHeader{}
+		for key, paths := range fileMap {
+			fhs := r.MultipartForm.File[key]
+			if len(fhs) == 0 {
+				graphqlUploadError(w, http.StatusBadRequest, fmt.Sprintf("no file sent for %q", key))
+				return
+			}
+			files[key] = fhs[0]
+
+			for _, path := range paths {
+				if err := setUploadPath(operations, strings.Split(path, "."), key); err != nil {
+					graphqlUploadError(w, http.StatusBadRequest, fmt.Sprintf("invalid path %q: %s", path, err))
+					return
+				}
+			}
+		}
+
+		body, err := json.Marshal(operations)
+		if err != nil {
+			log.WithError(err).Error("could not encode operations")
+			internalErrorHandler(w, r)
+			return
+		}
+
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+		r.Header.Set("Content-Type", "application/json")
+
+		next.ServeHTTP(w, r.WithContext(graphql.WithUploads(r.Context(), files)))
+	})
+}
+
+// setUploadPath sets the value at a path like "variables.files.0" in the
+// decoded operations.
+func setUploadPath(v interface{}, path []string, value string) error {
+	if len(path) == 0 {
+		return fmt.Errorf("empty path")
+	}
+
+	switch obj := v.(type) {
+	case map[string]interface{}:
+		if len(path) == 1 {
+			obj[path[0]] = value
+			return nil
+		}
+
+		child, ok := obj[path[0]]
+		if !ok {
+			return fmt.Errorf("%q does not exist", path[0])
+		}
+		return setUploadPath(child, path[1:], value)
+	case []interface{}:
+		i, err := strconv.Atoi(path[0])
+		if err != nil || i < 0 || i >= len(obj) {
+			return fmt.Errorf("%q is not an index", path[0])
+		}
+
+		if len(path) == 1 {
+			obj[i] = value
+			return nil
+		}
+		return setUploadPath(obj[i], path[1:], value)
+	default:
+		return fmt.Errorf("%q is not an object or list", path[0])
+	}
+}
+
+// graphqlUploadError renders an error the way GraphQL responses do.
+func graphqlUploadError(w http.ResponseWriter, status int, message string) {
+	err := Renderer.JSON(w, status, map[string]interface{}{
+		"errors": []map[string]string{{"message": message}},
+	})
+	if err != nil {
+		log.WithError(err).Error("could not render json")
+	}
+}
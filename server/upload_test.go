@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/icco/graphql"
+)
+
+func TestSetUploadPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{"variable", "variables.file", `{"variables":{"file":"0","files":[null,null]}}`, false},
+		{"list item", "variables.files.1", `{"variables":{"file":null,"files":[null,"0"]}}`, false},
+		{"missing key", "variables.other.file", "", true},
+		{"bad index", "variables.files.2", "", true},
+		{"not an index", "variables.files.x", "", true},
+		{"through a value", "variables.file.x", "", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var ops map[string]interface{}
+			if err := json.Unmarshal([]byte(`{"variables":{"file":null,"files":[null,null]}}`), &ops); err != nil {
+				t.Fatalf("could not decode operations: %+v", err)
+			}
+
+			err := setUploadPath(ops, strings.Split(tc.path, "."), "0")
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got %+v", ops)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("setUploadPath: %+v", err)
+			}
+
+			var want map[string]interface{}
+			if err := json.Unmarshal([]byte(tc.want), &want); err != nil {
+				t.Fatalf("could not decode want: %+v", err)
+			}
+
+			if !reflect.DeepEqual(ops, want) {
+				t.Errorf("got %+v, want %+v", ops, want)
+			}
+		})
+	}
+
+	if err := setUploadPath(map[string]interface{}{}, nil, "0"); err == nil {
+		t.Error("expected an error for an empty path")
+	}
+}
+
+// multipartUpload returns a GraphQL multipart request uploading one file as
+// the variable file.
+func multipartUpload(t *testing.T, operations, fileMap string) *http.Request {
+	t.Helper()
+
+	body := &bytes.Buffer{}
+	mw := multipart.NewWriter(body)
+	mw.WriteField("operations", operations)
+	mw.WriteField("map", fileMap)
+
+	fw, err := mw.CreateFormFile("0", "photo.jpg")
+	if err != nil {
+		t.Fatalf("could not create file: %+v", err)
+	}
+	fw.Write([]byte("jpeg"))
+
+	if err := mw.Close(); err != nil {
+		t.Fatalf("could not close multipart writer: %+v", err)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/graphql", body)
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+	return r
+}
+
+func TestGraphqlUploadMiddleware(t *testing.T) {
+	var gotBody string
+	var gotFile string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		gotBody = string(b)
+
+		var u graphql.Upload
+		if err := u.UnmarshalGQL("0"); err != nil {
+			t.Fatalf("UnmarshalGQL: %+v", err)
+		}
+
+		f, name, err := u.Open(r.Context())
+		if err != nil {
+			t.Fatalf("Open: %+v", err)
+		}
+		defer f.Close()
+
+		data, _ := ioutil.ReadAll(f)
+		gotFile = name + ":" + string(data)
+	})
+
+	operations := `{"query":"mutation($file: Upload!) { uploadPhoto(file: $file) { id } }","variables":{"file":null}}`
+	r := multipartUpload(t, operations, `{"0":["variables.file"]}`)
+	r = r.WithContext(graphql.WithUser(r.Context(), &graphql.User{ID: "test"}))
+
+	w := httptest.NewRecorder()
+	graphqlUploadMiddleware(next).ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("got %d: %s", w.Code, w.Body.String())
+	}
+
+	var ops map[string]interface{}
+	if err := json.Unmarshal([]byte(gotBody), &ops); err != nil {
+		t.Fatalf("could not decode body %q: %+v", gotBody, err)
+	}
+
+	if v := ops["variables"].(map[string]interface{})["file"]; v != "0" {
+		t.Errorf("variables.file = %v, want 0", v)
+	}
+
+	if gotFile != "photo.jpg:jpeg" {
+		t.Errorf("got file %q", gotFile)
+	}
+}
+
+func TestGraphqlUploadMiddlewareErrors(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the request should not have been passed on")
+	})
+
+	operations := `{"variables":{"file":null}}`
+	user := &graphql.User{ID: "test"}
+
+	tests := []struct {
+		name string
+		r    *http.Request
+		user *graphql.User
+		want int
+	}{
+		{"anonymous", multipartUpload(t, operations, `{"0":["variables.file"]}`), nil, http.StatusForbidden},
+		{"bad operations", multipartUpload(t, "[", `{"0":["variables.file"]}`), user, http.StatusBadRequest},
+		{"bad map", multipartUpload(t, operations, "["), user, http.StatusBadRequest},
+		{"missing file", multipartUpload(t, operations, `{"1":["variables.file"]}`), user, http.StatusBadRequest},
+		{"bad path", multipartUpload(t, operations, `{"0":["variables.other.file"]}`), user, http.StatusBadRequest},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.r
+			if tc.user != nil {
+				r = r.WithContext(graphql.WithUser(r.Context(), tc.user))
+			}
+
+			w := httptest.NewRecorder()
+			graphqlUploadMiddleware(next).ServeHTTP(w, r)
+
+			if w.Code != tc.want {
+				t.Errorf("got %d, want %d: %s", w.Code, tc.want, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestGraphqlUploadMiddlewarePassesJSON(t *testing.T) {
+	called := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ time }"}`))
+	r.Header.Set("Content-Type", "application/json")
+
+	graphqlUploadMiddleware(next).ServeHTTP(httptest.NewRecorder(), r)
+	if !called {
+		t.Error("JSON requests should be passed on")
+	}
+}
@@ -0,0 +1,129 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// slugRetries is how many more times a save is tried when another row takes
+// its slug first.
+const slugRetries = 5
+
+// slugTables maps the kinds of things that have slugs to the table they are
+// stored in. Every table has an id and a unique slug column.
+var slugTables = map[string]string{
+	"page": "pages",
+	"post": "posts",
+}
+
+// uniqueSlug returns base, or base with the lowest numeric suffix that no
+// other row of kind uses. id is the row the slug is for, so it can keep its
+// own slug.
+func uniqueSlug(ctx context.Context, kind, id, base string) (string, error) {
+	table, ok := slugTables[kind]
+	if !ok {
+		return "", fmt.Errorf("%s does not have slugs", kind)
+	}
+
+	if base == "" {
+		base = kind
+	}
+
+	slug := base
+	for i := 2; ; i++ {
+		var taken bool
+		row := db.QueryRowContext(ctx, fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM %s WHERE slug = $1 AND id::text <> $2)", table), slug, id)
+		if err := row.Scan(&taken); err != nil {
+			return "", err
+		}
+
+		if !taken {
+			return slug, nil
+		}
+
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
+// saveWithUniqueSlug picks a slug for the row of kind with id, starting from
+// base, and calls save with it. The unique index on slugs is what keeps them
+// unique: if another row takes the slug before save finishes, save fails and
+// is called again with a new slug.
+func saveWithUniqueSlug(ctx context.Context, kind, id, base string, save func(slug string) error) error {
+	for i := 0; ; i++ {
+		slug, err := uniqueSlug(ctx, kind, id, base)
+		if err != nil {
+			return err
+		}
+
+		err = save(slug)
+		if i == slugRetries || !isSlugConflict(err) {
+			return err
+		}
+	}
+}
+
+// isSlugConflict reports whether err is from saving a slug another row has.
+func isSlugConflict(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == "23505" && strings.HasSuffix(pqErr.Constraint, "_slug_idx")
+}
+
+// currentSlug returns the slug a row of kind has in the database, or "" if it
+// has not been saved.
+func currentSlug(ctx context.Context, kind, id string) (string, error) {
+	var slug sql.NullString
+	row := db.QueryRowContext(ctx, fmt.Sprintf("SELECT slug FROM %s WHERE id::text = $1", slugTables[kind]), id)
+	err := row.Scan(&slug)
+	switch {
+	case err == sql.ErrNoRows:
+		return "", nil
+	case err != nil:
+		return "", err
+	default:
+		return slug.String, nil
+	}
+}
+
+// recordSlugChange makes old redirect to the row of kind with id, which now
+// uses slug. The new slug stops redirecting anywhere else.
+func recordSlugChange(ctx context.Context, ex execer, kind, id, old, slug string) error {
+	if _, err := ex.ExecContext(ctx, "DELETE FROM slug_redirects WHERE kind = $1 AND slug = $2", kind, slug); err != nil {
+		return err
+	}
+
+	if old == "" || old == slug {
+		return nil
+	}
+
+	_, err := ex.ExecContext(ctx, `
+INSERT INTO slug_redirects(kind, slug, target_id, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (kind, slug) DO UPDATE
+SET (target_id, created_at) = ($3, $4)
+WHERE slug_redirects.kind = $1 AND slug_redirects.slug = $2;
+`, kind, old, id, time.Now())
+
+	return err
+}
+
+// slugRedirect returns the ID of the row of kind that used to have slug, or ""
+// if none did.
+func slugRedirect(ctx context.Context, kind, slug string) (string, error) {
+	var id string
+	row := db.QueryRowContext(ctx, "SELECT target_id FROM slug_redirects WHERE kind = $1 AND slug = $2", kind, slug)
+	err := row.Scan(&id)
+	switch {
+	case err == sql.ErrNoRows:
+		return "", nil
+	case err != nil:
+		return "", err
+	default:
+		return id, nil
+	}
+}
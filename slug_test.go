@@ -0,0 +1,145 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+func TestIsSlugConflict(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{errors.New("boom"), false},
+		{&pq.Error{Code: "23505", Constraint: "pages_slug_idx"}, true},
+		{&pq.Error{Code: "23505", Constraint: "posts_slug_idx"}, true},
+		{&pq.Error{Code: "23505", Constraint: "posts_pkey"}, false},
+		{&pq.Error{Code: "23503", Constraint: "posts_slug_idx"}, false},
+	}
+
+	for _, tc := range tests {
+		if got := isSlugConflict(tc.err); got != tc.want {
+			t.Errorf("isSlugConflict(%v) = %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
+func TestPostSlug(t *testing.T) {
+	for in, want := range map[string]string{
+		"Hello World": "hello-world",
+		"2019":        "post-2019",
+	} {
+		if got := postSlug(in); got != want {
+			t.Errorf("postSlug(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestPostKeepsSlug(t *testing.T) {
+	testDB(t)
+
+	ctx := context.Background()
+	title := "Slug " + uuid.New().String()
+	p := &Post{Title: title, Content: "content"}
+	if err := p.Save(ctx); err != nil {
+		t.Fatalf("Save: %+v", err)
+	}
+
+	slug := p.Slug
+	if slug != Slugify(title) {
+		t.Errorf("Slug = %q, want %q", slug, Slugify(title))
+	}
+
+	p.Title = "Renamed " + title
+	if err := p.Save(ctx); err != nil {
+		t.Fatalf("Save: %+v", err)
+	}
+
+	if p.Slug != slug {
+		t.Errorf("Slug changed to %q, want %q", p.Slug, slug)
+	}
+
+	other := &Post{Title: title, Content: "content"}
+	if err := other.Save(ctx); err != nil {
+		t.Fatalf("Save: %+v", err)
+	}
+
+	if other.Slug != slug+"-2" {
+		t.Errorf("Slug = %q, want %q", other.Slug, slug+"-2")
+	}
+}
+
+// TestSlugMigration checks that the migration adding unique slugs renames
+// duplicates without colliding with slugs that are already taken, so that the
+// unique indexes can be built.
+func TestSlugMigration(t *testing.T) {
+	testDB(t)
+
+	// slug_redirects already exists, so only run the part of the migration
+	// that works on pages and posts.
+	script := testMigration(t, "Add unique slugs and slug redirects")
+	script = script[:strings.Index(script, "CREATE TABLE slug_redirects")]
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx: %+v", err)
+	}
+	defer tx.Rollback()
+
+	for _, q := range []string{
+		"CREATE TEMPORARY TABLE pages (id text, slug text, title text, created_at timestamp with time zone) ON COMMIT DROP",
+		"CREATE TEMPORARY TABLE posts (id bigint, title text, created_at timestamp with time zone) ON COMMIT DROP",
+		`INSERT INTO pages(id, slug, title, created_at) VALUES
+		  ('a', 'foo', 'Foo', '2019-01-01'),
+		  ('b', 'foo-2', 'Foo 2', '2019-01-02'),
+		  ('c', 'foo', 'Foo again', '2019-01-03'),
+		  ('d', '', 'Hello World', '2019-01-04'),
+		  ('e', NULL, '', '2019-01-05')`,
+		`INSERT INTO posts(id, title, created_at) VALUES
+		  (1, 'Hello', '2019-01-01'),
+		  (2, 'Hello', '2019-01-02'),
+		  (3, '2019', '2019-01-03'),
+		  (4, NULL, '2019-01-04')`,
+	} {
+		if _, err := tx.ExecContext(ctx, q); err != nil {
+			t.Fatalf("could not set up tables: %+v", err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		t.Fatalf("could not run migration: %+v", err)
+	}
+
+	for table, want := range map[string]map[string]string{
+		"pages": {"a": "foo", "b": "foo-2", "c": "foo-3", "d": "hello-world", "e": "page"},
+		"posts": {"1": "hello", "2": "hello-2", "3": "post-2019", "4": "post"},
+	} {
+		rows, err := tx.QueryContext(ctx, "SELECT id::text, slug FROM "+table)
+		if err != nil {
+			t.Fatalf("could not query: %+v", err)
+		}
+
+		got := map[string]string{}
+		for rows.Next() {
+			var id, slug string
+			if err := rows.Scan(&id, &slug); err != nil {
+				t.Fatalf("could not scan: %+v", err)
+			}
+			got[id] = slug
+		}
+		rows.Close()
+
+		for id, slug := range want {
+			if got[id] != slug {
+				t.Errorf("%s %s has slug %q, want %q", table, id, got[id], slug)
+			}
+		}
+	}
+}
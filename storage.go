@@ -0,0 +1,396 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"cloud.google.com/go/storage"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+const (
+	// StorageBucketName is the bucket name we are uploading to.
+	StorageBucketName = "icco-cloud"
+
+	// storageCacheControl is the Cache-Control header stored files are served
+	// with.
+	storageCacheControl = "public, max-age=86400"
+)
+
+// Storage is somewhere uploaded files are kept. Paths are slash separated and
+// relative, like "photos/2019/id.jpg".
+type Storage interface {
+	// Put stores the contents of r at path, replacing anything already there.
+	Put(ctx context.Context, path, contentType string, r io.Reader) error
+
+	// Delete removes the file at path. It is not an error if there is no file.
+	Delete(ctx context.Context, path string) error
+
+	// URL returns where the file at path can be downloaded from.
+	URL(path string) string
+}
+
+// checksumStorage is implemented by storage backends that can check that a
+// file arrived intact.
+type checksumStorage interface {
+	// PutChecksum is like Put, but fails if what is stored does not have the
+	// CRC32C checksum crc.
+	PutChecksum(ctx context.Context, path, contentType string, r io.Reader, crc uint32) error
+}
+
+// putFile stores data at path in the current storage backend, checking it
+// arrived intact if the backend can.
+func putFile(ctx context.Context, path, contentType string, data []byte) error {
+	s := CurrentStorage()
+	if cs, ok := s.(checksumStorage); ok {
+		return cs.PutChecksum(ctx, path, contentType, bytes.NewReader(data), crc32.Checksum(data, crc32cTable))
+	}
+
+	return s.Put(ctx, path, contentType, bytes.NewReader(data))
+}
+
+// activeStorage is where uploads go. The server sets it when it starts.
+var activeStorage Storage = noStorage{}
+
+// CurrentStorage returns the storage backend uploads go to.
+func CurrentStorage() Storage {
+	return activeStorage
+}
+
+// SetStorage replaces the storage backend uploads go to.
+func SetStorage(s Storage) {
+	activeStorage = s
+}
+
+// NewStorageFromEnv returns the storage backend named by the STORAGE_BACKEND
+// environment variable, which is one of "gcs" (the default), "local" or "s3".
+//
+// The gcs backend uses the bucket in STORAGE_BUCKET, or StorageBucketName. The
+// local backend keeps files in STORAGE_DIR, or "./data", and serves them from
+// STORAGE_URL, or http://localhost:8080. The s3 backend uses S3_BUCKET,
+// S3_REGION and S3_ENDPOINT, with S3_PUBLIC_URL overriding where files are
+// downloaded from, and takes credentials from the usual AWS variables.
+func NewStorageFromEnv() (Storage, error) {
+	switch backend := os.Getenv("STORAGE_BACKEND"); backend {
+	case "local":
+		dir := os.Getenv("STORAGE_DIR")
+		if dir == "" {
+			dir = "./data"
+		}
+
+		baseURL := os.Getenv("STORAGE_URL")
+		if baseURL == "" {
+			baseURL = "http://localhost:8080"
+		}
+
+		return NewLocalStorage(dir, baseURL), nil
+	case "s3":
+		s, err := NewS3Storage(os.Getenv("S3_BUCKET"), os.Getenv("S3_REGION"), os.Getenv("S3_ENDPOINT"), os.Getenv("S3_PUBLIC_URL"))
+		if err != nil {
+			return nil, fmt.Errorf("could not configure s3 storage: %+v", err)
+		}
+		return s, nil
+	case "", "gcs":
+		bucket := os.Getenv("STORAGE_BUCKET")
+		if bucket == "" {
+			bucket = StorageBucketName
+		}
+
+		return NewGCSStorage(bucket), nil
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", backend)
+	}
+}
+
+// noStorage is used until storage is set, and fails to store anything.
+type noStorage struct{}
+
+func (noStorage) Put(ctx context.Context, path, contentType string, r io.Reader) error {
+	return fmt.Errorf("storage is not configured")
+}
+
+func (noStorage) Delete(ctx context.Context, path string) error {
+	return fmt.Errorf("storage is not configured")
+}
+
+func (noStorage) URL(path string) string {
+	return ""
+}
+
+// GCSStorage keeps files in a Google Cloud Storage bucket, readable by
+// everyone.
+type GCSStorage struct {
+	Bucket string
+
+	mu     sync.Mutex
+	client *storage.Client
+}
+
+// NewGCSStorage returns storage for a Google Cloud Storage bucket.
+func NewGCSStorage(bucket string) *GCSStorage {
+	return &GCSStorage{Bucket: bucket}
+}
+
+func (s *GCSStorage) bucket(ctx context.Context) (*storage.BucketHandle, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client == nil {
+		client, err := storage.NewClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.client = client
+	}
+
+	return s.client.Bucket(s.Bucket), nil
+}
+
+// Put uploads a file to the bucket.
+func (s *GCSStorage) Put(ctx context.Context, path, contentType string, r io.Reader) error {
+	return s.put(ctx, path, contentType, r, nil)
+}
+
+// PutChecksum uploads a file to the bucket, which rejects it if it does not
+// have the CRC32C checksum crc.
+func (s *GCSStorage) PutChecksum(ctx context.Context, path, contentType string, r io.Reader, crc uint32) error {
+	return s.put(ctx, path, contentType, r, &crc)
+}
+
+func (s *GCSStorage) put(ctx context.Context, path, contentType string, r io.Reader, crc *uint32) error {
+	bkt, err := s.bucket(ctx)
+	if err != nil {
+		return err
+	}
+
+	uploader := bkt.Object(path).NewWriter(ctx)
+	uploader.ACL = []storage.ACLRule{{Entity: storage.AllUsers, Role: storage.RoleReader}}
+	uploader.ContentType = contentType
+	uploader.CacheControl = storageCacheControl
+
+	if crc != nil {
+		uploader.CRC32C = *crc
+		uploader.SendCRC32C = true
+	}
+
+	if _, err := io.Copy(uploader, r); err != nil {
+		uploader.Close()
+		return err
+	}
+
+	return uploader.Close()
+}
+
+// Delete removes a file from the bucket.
+func (s *GCSStorage) Delete(ctx context.Context, path string) error {
+	bkt, err := s.bucket(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = bkt.Object(path).Delete(ctx)
+	if err == storage.ErrObjectNotExist {
+		return nil
+	}
+
+	return err
+}
+
+// URL returns the public URL of a file in the bucket.
+func (s *GCSStorage) URL(path string) string {
+	return fmt.Sprintf("https://storage.googleapis.com/%s/%s", s.Bucket, path)
+}
+
+// LocalStorage keeps files in a directory, for development. The server serves
+// them under /photos.
+type LocalStorage struct {
+	Dir     string
+	BaseURL string
+}
+
+// NewLocalStorage returns storage in dir, with files served from baseURL.
+func NewLocalStorage(dir, baseURL string) *LocalStorage {
+	return &LocalStorage{Dir: dir, BaseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+// file returns the file path for a storage path, making sure it stays inside
+// of Dir.
+func (s *LocalStorage) file(path string) (string, error) {
+	clean := filepath.Clean("/" + path)
+	if clean == "/" {
+		return "", fmt.Errorf("invalid path %q", path)
+	}
+
+	return filepath.Join(s.Dir, filepath.FromSlash(clean)), nil
+}
+
+// Open opens a stored file for reading. Only files that were stored can be
+// opened: directories and the temporary files Put writes to are not found.
+// The caller must close the file.
+func (s *LocalStorage) Open(path string) (*os.File, os.FileInfo, error) {
+	name, err := s.file(path)
+	if err != nil {
+		return nil, nil, os.ErrNotExist
+	}
+
+	if strings.HasPrefix(filepath.Base(name), ".") {
+		return nil, nil, os.ErrNotExist
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+
+	if !fi.Mode().IsRegular() {
+		f.Close()
+		return nil, nil, os.ErrNotExist
+	}
+
+	return f, fi, nil
+}
+
+// Put writes a file into the directory.
+func (s *LocalStorage) Put(ctx context.Context, path, contentType string, r io.Reader) error {
+	name, err := s.file(path)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(name), ".upload-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), name)
+}
+
+// Delete removes a file from the directory.
+func (s *LocalStorage) Delete(ctx context.Context, path string) error {
+	name, err := s.file(path)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(name)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	return err
+}
+
+// URL returns where the server serves a file from.
+func (s *LocalStorage) URL(path string) string {
+	return fmt.Sprintf("%s/%s", s.BaseURL, path)
+}
+
+// S3Storage keeps files in an S3 compatible bucket, readable by everyone.
+type S3Storage struct {
+	Bucket    string
+	Region    string
+	Endpoint  string
+	PublicURL string
+
+	client   *s3.S3
+	uploader *s3manager.Uploader
+}
+
+// NewS3Storage returns storage for an S3 bucket. endpoint is only needed for
+// services other than AWS, and publicURL only if files are not downloaded
+// from the bucket directly.
+func NewS3Storage(bucket, region, endpoint, publicURL string) (*S3Storage, error) {
+	if bucket == "" {
+		return nil, fmt.Errorf("no bucket specified")
+	}
+
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	cfg := aws.NewConfig().WithRegion(region)
+	if endpoint != "" {
+		cfg = cfg.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &S3Storage{
+		Bucket:    bucket,
+		Region:    region,
+		Endpoint:  strings.TrimSuffix(endpoint, "/"),
+		PublicURL: strings.TrimSuffix(publicURL, "/"),
+		client:    s3.New(sess),
+		uploader:  s3manager.NewUploader(sess),
+	}, nil
+}
+
+// Put uploads a file to the bucket.
+func (s *S3Storage) Put(ctx context.Context, path, contentType string, r io.Reader) error {
+	_, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:       aws.String(s.Bucket),
+		Key:          aws.String(path),
+		Body:         r,
+		ACL:          aws.String(s3.ObjectCannedACLPublicRead),
+		ContentType:  aws.String(contentType),
+		CacheControl: aws.String(storageCacheControl),
+	})
+
+	return err
+}
+
+// Delete removes a file from the bucket.
+func (s *S3Storage) Delete(ctx context.Context, path string) error {
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.Bucket),
+		Key:    aws.String(path),
+	})
+
+	return err
+}
+
+// URL returns the public URL of a file in the bucket.
+func (s *S3Storage) URL(path string) string {
+	switch {
+	case s.PublicURL != "":
+		return fmt.Sprintf("%s/%s", s.PublicURL, path)
+	case s.Endpoint != "":
+		return fmt.Sprintf("%s/%s/%s", s.Endpoint, s.Bucket, path)
+	default:
+		return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", s.Bucket, s.Region, path)
+	}
+}
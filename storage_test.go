@@ -0,0 +1,107 @@
+package graphql
+
+import (
+	"context"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalStorageOpen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "storage")
+	if err != nil {
+		t.Fatalf("TempDir: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := NewLocalStorage(dir, "http://localhost:8080")
+	if err := s.Put(context.Background(), "photos/2019/a.jpg", "image/jpeg", strings.NewReader("jpeg")); err != nil {
+		t.Fatalf("Put: %+v", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "photos", "2019", ".upload-123"), []byte("partial"), 0644); err != nil {
+		t.Fatalf("WriteFile: %+v", err)
+	}
+
+	f, fi, err := s.Open("photos/2019/a.jpg")
+	if err != nil {
+		t.Fatalf("Open: %+v", err)
+	}
+	f.Close()
+
+	if fi.Size() != 4 {
+		t.Errorf("Size = %d, want 4", fi.Size())
+	}
+
+	for _, path := range []string{"", "photos", "photos/2019", "photos/2019/.upload-123", "photos/2019/missing.jpg"} {
+		if f, _, err := s.Open(path); err == nil {
+			f.Close()
+			t.Errorf("Open(%q) succeeded", path)
+		} else if !os.IsNotExist(err) {
+			t.Errorf("Open(%q) = %+v, want a not exist error", path, err)
+		}
+	}
+}
+
+func TestNewStorageFromEnv(t *testing.T) {
+	old, ok := os.LookupEnv("STORAGE_BACKEND")
+	defer func() {
+		if ok {
+			os.Setenv("STORAGE_BACKEND", old)
+		} else {
+			os.Unsetenv("STORAGE_BACKEND")
+		}
+	}()
+
+	os.Setenv("STORAGE_BACKEND", "local")
+	s, err := NewStorageFromEnv()
+	if err != nil {
+		t.Fatalf("NewStorageFromEnv: %+v", err)
+	}
+
+	if _, ok := s.(*LocalStorage); !ok {
+		t.Errorf("got %T, want *LocalStorage", s)
+	}
+
+	os.Setenv("STORAGE_BACKEND", "floppy")
+	if _, err := NewStorageFromEnv(); err == nil {
+		t.Error("expected an error for an unknown backend")
+	}
+}
+
+// checksumTestStorage records the checksum it was asked to check.
+type checksumTestStorage struct {
+	noStorage
+	crc *uint32
+}
+
+func (s *checksumTestStorage) PutChecksum(ctx context.Context, path, contentType string, r io.Reader, crc uint32) error {
+	s.crc = &crc
+	return nil
+}
+
+func TestPutFileChecksum(t *testing.T) {
+	old := CurrentStorage()
+	defer SetStorage(old)
+
+	s := &checksumTestStorage{}
+	SetStorage(s)
+
+	data := []byte("photo")
+	if err := putFile(context.Background(), "photos/2019/a.jpg", "image/jpeg", data); err != nil {
+		t.Fatalf("putFile: %+v", err)
+	}
+
+	if want := crc32.Checksum(data, crc32cTable); s.crc == nil || *s.crc != want {
+		t.Errorf("checksum = %v, want %d", s.crc, want)
+	}
+
+	SetStorage(noStorage{})
+	if err := putFile(context.Background(), "photos/2019/a.jpg", "image/jpeg", data); err == nil {
+		t.Error("expected Put to be used, which fails for noStorage")
+	}
+}
@@ -4,34 +4,52 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/lib/pq"
 )
 
+// tweetColumns are the columns selected for every tweet query, in the order
+// scanTweet expects them.
+const tweetColumns = `id, text, hashtags, symbols, user_mentions, urls, screen_name, favorites, retweets, posted,
+  COALESCE(in_reply_to_status_id, ''), COALESCE(in_reply_to_screen_name, ''), COALESCE(quoted_status_id, ''), COALESCE(conversation_id, '')`
+
 // A Tweet is an archived tweet.
 type Tweet struct {
-	ID            string    `json:"id"`
-	Text          string    `json:"text"`
-	Hashtags      []string  `json:"hashtags"`
-	Symbols       []string  `json:"symbols"`
-	UserMentions  []string  `json:"user_mentions"`
-	Urls          []URI     `json:"urls"`
-	ScreenName    string    `json:"screen_name"`
-	FavoriteCount int       `json:"favorite_count"`
-	RetweetCount  int       `json:"retweet_count"`
-	Posted        time.Time `json:"posted"`
+	ID                  string    `json:"id"`
+	Text                string    `json:"text"`
+	Hashtags            []string  `json:"hashtags"`
+	Symbols             []string  `json:"symbols"`
+	UserMentions        []string  `json:"user_mentions"`
+	Urls                []URI     `json:"urls"`
+	ScreenName          string    `json:"screen_name"`
+	FavoriteCount       int       `json:"favorite_count"`
+	RetweetCount        int       `json:"retweet_count"`
+	Posted              time.Time `json:"posted"`
+	InReplyToStatusID   string    `json:"in_reply_to_status_id"`
+	InReplyToScreenName string    `json:"in_reply_to_screen_name"`
+	QuotedStatusID      string    `json:"quoted_status_id"`
+	ConversationID      string    `json:"conversation_id"`
 }
 
 // Save inserts or updates a tweet into the database.
 func (t *Tweet) Save(ctx context.Context) error {
+	if t.ConversationID == "" {
+		id, err := t.conversation(ctx)
+		if err != nil {
+			return err
+		}
+		t.ConversationID = id
+	}
+
 	if _, err := db.ExecContext(
 		ctx,
 		`
-INSERT INTO tweets(id, text, hashtags, symbols, user_mentions, urls, screen_name, favorites, retweets, posted, created_at, modified_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $11)
+INSERT INTO tweets(id, text, hashtags, symbols, user_mentions, urls, screen_name, favorites, retweets, posted, created_at, modified_at, in_reply_to_status_id, in_reply_to_screen_name, quoted_status_id, conversation_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $11, NULLIF($12, ''), NULLIF($13, ''), NULLIF($14, ''), NULLIF($15, ''))
 ON CONFLICT (id) DO UPDATE
-SET (text, hashtags, symbols, user_mentions, urls, screen_name, favorites, retweets, posted, modified_at) = ($2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+SET (text, hashtags, symbols, user_mentions, urls, screen_name, favorites, retweets, posted, modified_at, in_reply_to_status_id, in_reply_to_screen_name, quoted_status_id, conversation_id) = ($2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NULLIF($12, ''), NULLIF($13, ''), NULLIF($14, ''), NULLIF($15, ''))
 WHERE tweets.id = $1;
 `,
 		t.ID,
@@ -45,6 +63,10 @@ WHERE tweets.id = $1;
 		t.RetweetCount,
 		t.Posted,
 		time.Now(),
+		t.InReplyToStatusID,
+		t.InReplyToScreenName,
+		t.QuotedStatusID,
+		t.ConversationID,
 	); err != nil {
 		return err
 	}
@@ -52,32 +74,68 @@ WHERE tweets.id = $1;
 	return nil
 }
 
+// conversation guesses the conversation a tweet belongs to when it was not
+// given one. Tweets that are not replies start their own conversation, and
+// replies join the conversation of the tweet they reply to if we have it.
+func (t *Tweet) conversation(ctx context.Context) (string, error) {
+	if t.InReplyToStatusID == "" {
+		return t.ID, nil
+	}
+
+	var id string
+	row := db.QueryRowContext(ctx, "SELECT COALESCE(conversation_id, id) FROM tweets WHERE id = $1", t.InReplyToStatusID)
+	switch err := row.Scan(&id); {
+	case err == sql.ErrNoRows:
+		return t.InReplyToStatusID, nil
+	case err != nil:
+		return "", fmt.Errorf("Error running get query: %+v", err)
+	}
+
+	return id, nil
+}
+
 // IsLinkable exists to show that this method implements the Linkable type in
 // graphql.
 func (t *Tweet) IsLinkable() {}
 
-// GetTweet returns a single tweet by id.
-func GetTweet(ctx context.Context, id string) (*Tweet, error) {
-	var tweet Tweet
+type tweetScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanTweet(row tweetScanner) (*Tweet, error) {
+	tweet := new(Tweet)
 	uris := []string{}
-	row := db.QueryRowContext(ctx, "SELECT id, text, hashtags, symbols, user_mentions, urls, screen_name, favorites, retweets, posted FROM tweets WHERE id = $1", id)
-	err := row.Scan(&tweet.ID, &tweet.Text, pq.Array(&tweet.Hashtags), pq.Array(&tweet.Symbols), pq.Array(&tweet.UserMentions), pq.Array(&uris), &tweet.ScreenName, &tweet.FavoriteCount, &tweet.RetweetCount, &tweet.Posted)
-	switch {
-	case err == sql.ErrNoRows:
-		return nil, fmt.Errorf("No tweet %s", id)
-	case err != nil:
-		return nil, fmt.Errorf("Error running get query: %+v", err)
-	default:
-		for _, v := range uris {
-			tweet.Urls = append(tweet.Urls, NewURI(v))
-		}
-		return &tweet, nil
+	err := row.Scan(
+		&tweet.ID,
+		&tweet.Text,
+		pq.Array(&tweet.Hashtags),
+		pq.Array(&tweet.Symbols),
+		pq.Array(&tweet.UserMentions),
+		pq.Array(&uris),
+		&tweet.ScreenName,
+		&tweet.FavoriteCount,
+		&tweet.RetweetCount,
+		&tweet.Posted,
+		&tweet.InReplyToStatusID,
+		&tweet.InReplyToScreenName,
+		&tweet.QuotedStatusID,
+		&tweet.ConversationID,
+	)
+	if err != nil {
+		return nil, err
 	}
+
+	for _, v := range uris {
+		tweet.Urls = append(tweet.Urls, NewURI(v))
+	}
+
+	return tweet, nil
 }
 
-// GetTweets returns an array of tweets from the database.
-func GetTweets(ctx context.Context, limit, offset int) ([]*Tweet, error) {
-	rows, err := db.QueryContext(ctx, "SELECT id, text, hashtags, symbols, user_mentions, urls, screen_name, favorites, retweets, posted FROM tweets ORDER BY posted DESC LIMIT $1 OFFSET $2", limit, offset)
+// queryTweets runs a query that selects tweetColumns and returns the tweets
+// found.
+func queryTweets(ctx context.Context, query string, args ...interface{}) ([]*Tweet, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -85,17 +143,11 @@ func GetTweets(ctx context.Context, limit, offset int) ([]*Tweet, error) {
 
 	tweets := make([]*Tweet, 0)
 	for rows.Next() {
-		uris := []string{}
-		tweet := new(Tweet)
-		err := rows.Scan(&tweet.ID, &tweet.Text, pq.Array(&tweet.Hashtags), pq.Array(&tweet.Symbols), pq.Array(&tweet.UserMentions), pq.Array(&uris), &tweet.ScreenName, &tweet.FavoriteCount, &tweet.RetweetCount, &tweet.Posted)
+		tweet, err := scanTweet(rows)
 		if err != nil {
 			return nil, err
 		}
 
-		for _, v := range uris {
-			tweet.Urls = append(tweet.Urls, NewURI(v))
-		}
-
 		tweets = append(tweets, tweet)
 	}
 
@@ -106,6 +158,25 @@ func GetTweets(ctx context.Context, limit, offset int) ([]*Tweet, error) {
 	return tweets, nil
 }
 
+// GetTweet returns a single tweet by id.
+func GetTweet(ctx context.Context, id string) (*Tweet, error) {
+	row := db.QueryRowContext(ctx, "SELECT "+tweetColumns+" FROM tweets WHERE id = $1", id)
+	tweet, err := scanTweet(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("No tweet %s", id)
+	case err != nil:
+		return nil, fmt.Errorf("Error running get query: %+v", err)
+	default:
+		return tweet, nil
+	}
+}
+
+// GetTweets returns an array of tweets from the database.
+func GetTweets(ctx context.Context, limit, offset int) ([]*Tweet, error) {
+	return queryTweets(ctx, "SELECT "+tweetColumns+" FROM tweets ORDER BY posted DESC LIMIT $1 OFFSET $2", limit, offset)
+}
+
 // URI returns a link to this tweet.
 func (t *Tweet) URI() URI {
 	return NewURI(fmt.Sprintf("https://twitter.com/%s/status/%s", t.ScreenName, t.ID))
@@ -113,31 +184,115 @@ func (t *Tweet) URI() URI {
 
 // GetTweetsByScreenName returns an array of tweets from the database filtered by screenname.
 func GetTweetsByScreenName(ctx context.Context, screenName string, limit, offset int) ([]*Tweet, error) {
-	rows, err := db.QueryContext(ctx, "SELECT id, text, hashtags, symbols, user_mentions, urls, screen_name, favorites, retweets, posted FROM tweets WHERE screen_name = $3 ORDER BY posted DESC LIMIT $1 OFFSET $2", limit, offset, screenName)
-	if err != nil {
-		return nil, err
+	return queryTweets(ctx, "SELECT "+tweetColumns+" FROM tweets WHERE screen_name = $3 ORDER BY posted DESC LIMIT $1 OFFSET $2", limit, offset, screenName)
+}
+
+// InReplyTo returns the tweet this tweet replies to, or nil if it is not a
+// reply or we have not archived the parent.
+func (t *Tweet) InReplyTo(ctx context.Context) (*Tweet, error) {
+	if t.InReplyToStatusID == "" {
+		return nil, nil
 	}
-	defer rows.Close()
 
-	tweets := make([]*Tweet, 0)
-	for rows.Next() {
-		uris := []string{}
-		tweet := new(Tweet)
-		err := rows.Scan(&tweet.ID, &tweet.Text, pq.Array(&tweet.Hashtags), pq.Array(&tweet.Symbols), pq.Array(&tweet.UserMentions), pq.Array(&uris), &tweet.ScreenName, &tweet.FavoriteCount, &tweet.RetweetCount, &tweet.Posted)
-		if err != nil {
-			return nil, err
-		}
+	return getArchivedTweet(ctx, t.InReplyToStatusID)
+}
 
-		for _, v := range uris {
-			tweet.Urls = append(tweet.Urls, NewURI(v))
-		}
+// Quoted returns the tweet this tweet quotes, or nil if it does not quote a
+// tweet or we have not archived it.
+func (t *Tweet) Quoted(ctx context.Context) (*Tweet, error) {
+	if t.QuotedStatusID == "" {
+		return nil, nil
+	}
 
-		tweets = append(tweets, tweet)
+	return getArchivedTweet(ctx, t.QuotedStatusID)
+}
+
+// Replies returns all archived tweets that directly reply to this tweet.
+func (t *Tweet) Replies(ctx context.Context) ([]*Tweet, error) {
+	return queryTweets(ctx, "SELECT "+tweetColumns+" FROM tweets WHERE in_reply_to_status_id = $1 ORDER BY posted ASC", t.ID)
+}
+
+// maxThreadDepth is how far Thread walks up a reply chain looking for its
+// first tweet, so that a cycle of replies cannot loop forever.
+const maxThreadDepth = 1000
+
+// Thread returns every archived tweet in the same thread as this tweet,
+// including itself. It walks up the reply chain to the first tweet we have,
+// then collects every reply beneath it along with anything else that shares
+// the conversation.
+func (t *Tweet) Thread(ctx context.Context) ([]*Tweet, error) {
+	query := `
+WITH RECURSIVE ancestors(id, parent, depth) AS (
+  SELECT id, in_reply_to_status_id, 0 FROM tweets WHERE id = $1
+  UNION ALL
+  SELECT t.id, t.in_reply_to_status_id, a.depth + 1
+  FROM tweets t JOIN ancestors a ON t.id = a.parent
+  WHERE a.depth < $3
+), descendants(id) AS (
+  SELECT id FROM (SELECT id FROM ancestors ORDER BY depth DESC LIMIT 1) AS root
+  UNION
+  SELECT t.id FROM tweets t JOIN descendants d ON t.in_reply_to_status_id = d.id
+)
+SELECT ` + tweetColumns + `
+FROM tweets
+WHERE id IN (SELECT id FROM descendants)
+  OR (conversation_id IS NOT NULL AND conversation_id = NULLIF($2, ''))
+ORDER BY posted ASC`
+
+	return queryTweets(ctx, query, t.ID, t.ConversationID, maxThreadDepth)
+}
+
+// getArchivedTweet is like GetTweet, but returns nil instead of an error if
+// the tweet has not been archived.
+func getArchivedTweet(ctx context.Context, id string) (*Tweet, error) {
+	row := db.QueryRowContext(ctx, "SELECT "+tweetColumns+" FROM tweets WHERE id = $1", id)
+	tweet, err := scanTweet(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("Error running get query: %+v", err)
+	default:
+		return tweet, nil
 	}
+}
 
-	if err = rows.Err(); err != nil {
-		return nil, err
+// SearchTweets returns tweets that match all of the given non-empty filters,
+// newest first. query matches anywhere in the text, hashtag and mention match
+// entities case insensitively, and from and to bound the posted time.
+func SearchTweets(ctx context.Context, query, hashtag, mention *string, from, to *time.Time, limit, offset int) ([]*Tweet, error) {
+	where := []string{}
+	args := []interface{}{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
 	}
 
-	return tweets, nil
+	if query != nil && *query != "" {
+		where = append(where, fmt.Sprintf("text ILIKE '%%' || %s || '%%'", arg(likeEscaper.Replace(*query))))
+	}
+
+	if hashtag != nil && *hashtag != "" {
+		where = append(where, fmt.Sprintf("EXISTS (SELECT 1 FROM UNNEST(hashtags) AS h WHERE lower(h) = lower(%s))", arg(strings.TrimPrefix(*hashtag, "#"))))
+	}
+
+	if mention != nil && *mention != "" {
+		where = append(where, fmt.Sprintf("EXISTS (SELECT 1 FROM UNNEST(user_mentions) AS m WHERE lower(m) = lower(%s))", arg(strings.TrimPrefix(*mention, "@"))))
+	}
+
+	if from != nil {
+		where = append(where, fmt.Sprintf("posted >= %s", arg(*from)))
+	}
+
+	if to != nil {
+		where = append(where, fmt.Sprintf("posted < %s", arg(*to)))
+	}
+
+	q := "SELECT " + tweetColumns + " FROM tweets"
+	if len(where) > 0 {
+		q += " WHERE " + strings.Join(where, " AND ")
+	}
+	q += fmt.Sprintf(" ORDER BY posted DESC LIMIT %s OFFSET %s", arg(limit), arg(offset))
+
+	return queryTweets(ctx, q, args...)
 }
@@ -0,0 +1,151 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// testTweet saves a tweet replying to parent, posted at the given minute. Its
+// conversation is its own id so that Thread can only find it through replies.
+func testTweet(t *testing.T, id, parent string, minute int) *Tweet {
+	t.Helper()
+
+	tw := &Tweet{
+		ID:                id,
+		Text:              id,
+		ScreenName:        "test",
+		Posted:            time.Date(2019, 1, 1, 0, minute, 0, 0, time.UTC),
+		InReplyToStatusID: parent,
+		ConversationID:    id,
+	}
+
+	if err := tw.Save(context.Background()); err != nil {
+		t.Fatalf("could not save tweet: %+v", err)
+	}
+
+	return tw
+}
+
+func tweetIDs(tweets []*Tweet) []string {
+	ids := make([]string, len(tweets))
+	for i, tw := range tweets {
+		ids[i] = tw.ID
+	}
+	return ids
+}
+
+func TestTweetThread(t *testing.T) {
+	testDB(t)
+	ctx := context.Background()
+
+	p := "test-" + uuid.New().String() + "-"
+	root := testTweet(t, p+"root", "", 0)
+	reply := testTweet(t, p+"reply", root.ID, 1)
+	nested := testTweet(t, p+"nested", reply.ID, 3)
+	other := testTweet(t, p+"other", root.ID, 2)
+
+	want := []string{root.ID, reply.ID, other.ID, nested.ID}
+	for _, tw := range []*Tweet{root, reply, nested, other} {
+		thread, err := tw.Thread(ctx)
+		if err != nil {
+			t.Fatalf("Thread(%s): %+v", tw.ID, err)
+		}
+
+		if got := tweetIDs(thread); fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("Thread(%s) = %v, want %v", tw.ID, got, want)
+		}
+	}
+}
+
+func TestTweetThreadCycle(t *testing.T) {
+	testDB(t)
+	ctx := context.Background()
+
+	p := "test-" + uuid.New().String() + "-"
+	a := testTweet(t, p+"a", p+"b", 0)
+	b := testTweet(t, p+"b", p+"a", 1)
+
+	thread, err := a.Thread(ctx)
+	if err != nil {
+		t.Fatalf("Thread: %+v", err)
+	}
+
+	want := []string{a.ID, b.ID}
+	if got := tweetIDs(thread); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Thread = %v, want %v", got, want)
+	}
+}
+
+func TestTweetThreadDepth(t *testing.T) {
+	testDB(t)
+	ctx := context.Background()
+
+	// A chain of replies longer than maxThreadDepth, where tweet n replies to
+	// tweet n-1.
+	p := "test-" + uuid.New().String() + "-"
+	n := maxThreadDepth + 5
+	if _, err := db.ExecContext(ctx, `
+INSERT INTO tweets(id, text, screen_name, posted, in_reply_to_status_id, conversation_id)
+SELECT $1 || i, '', 'test', $2::timestamptz + i * interval '1 second', CASE WHEN i > 0 THEN $1 || (i - 1) END, $1 || i
+FROM generate_series(0, $3) AS i`, p, time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), n); err != nil {
+		t.Fatalf("could not save tweets: %+v", err)
+	}
+
+	last, err := GetTweet(ctx, fmt.Sprintf("%s%d", p, n))
+	if err != nil {
+		t.Fatalf("GetTweet: %+v", err)
+	}
+
+	thread, err := last.Thread(ctx)
+	if err != nil {
+		t.Fatalf("Thread: %+v", err)
+	}
+
+	// Thread stops walking up maxThreadDepth tweets above the last one, then
+	// collects everything beneath that.
+	if len(thread) != maxThreadDepth+1 {
+		t.Fatalf("got %d tweets, want %d", len(thread), maxThreadDepth+1)
+	}
+
+	if want := fmt.Sprintf("%s%d", p, n-maxThreadDepth); thread[0].ID != want {
+		t.Errorf("thread starts at %s, want %s", thread[0].ID, want)
+	}
+}
+
+func TestTweetConversation(t *testing.T) {
+	testDB(t)
+	ctx := context.Background()
+
+	p := "test-" + uuid.New().String() + "-"
+	root := &Tweet{ID: p + "root", ScreenName: "test"}
+	if err := root.Save(ctx); err != nil {
+		t.Fatalf("could not save tweet: %+v", err)
+	}
+
+	tests := []struct {
+		name   string
+		tweet  *Tweet
+		expect string
+	}{
+		{"not a reply", &Tweet{ID: p + "new"}, p + "new"},
+		{"reply", &Tweet{ID: p + "reply", InReplyToStatusID: root.ID}, root.ID},
+		{"reply to a missing tweet", &Tweet{ID: p + "orphan", InReplyToStatusID: p + "missing"}, p + "missing"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.tweet.conversation(ctx)
+			if err != nil {
+				t.Fatalf("conversation: %+v", err)
+			}
+
+			if got != tc.expect {
+				t.Errorf("got %q, want %q", got, tc.expect)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// archiveTimeFormat is the format Twitter uses for created_at in data exports.
+const archiveTimeFormat = "Mon Jan 02 15:04:05 -0700 2006"
+
+// archiveEntry is a single element of the array in tweet.js. Newer exports wrap
+// each tweet in an object with a "tweet" key, older ones do not.
+type archiveEntry struct {
+	Tweet *archiveTweet `json:"tweet"`
+	archiveTweet
+}
+
+// archiveTweet is a tweet as it appears in the official Twitter data export.
+// Numbers are sometimes encoded as strings, so those fields use archiveCount.
+type archiveTweet struct {
+	IDStr                string       `json:"id_str"`
+	FullText             string       `json:"full_text"`
+	Text                 string       `json:"text"`
+	CreatedAt            string       `json:"created_at"`
+	FavoriteCount        archiveCount `json:"favorite_count"`
+	RetweetCount         archiveCount `json:"retweet_count"`
+	InReplyToStatusIDStr string       `json:"in_reply_to_status_id_str"`
+	InReplyToScreenName  string       `json:"in_reply_to_screen_name"`
+	QuotedStatusIDStr    string       `json:"quoted_status_id_str"`
+	Entities             struct {
+		Hashtags []struct {
+			Text string `json:"text"`
+		} `json:"hashtags"`
+		Symbols []struct {
+			Text string `json:"text"`
+		} `json:"symbols"`
+		UserMentions []struct {
+			ScreenName string `json:"screen_name"`
+		} `json:"user_mentions"`
+		URLs []struct {
+			ExpandedURL string `json:"expanded_url"`
+		} `json:"urls"`
+	} `json:"entities"`
+}
+
+// ParseTweetArchive reads a tweet.js or tweets.js file from a Twitter data
+// export and returns the tweets in it. The archive does not include the
+// author, so screenName is set on every tweet returned.
+func ParseTweetArchive(r io.Reader, screenName string) ([]*Tweet, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// The file is javascript of the form `window.YTD.tweet.part0 = [...]`, so
+	// skip to the start of the array.
+	start := bytes.IndexByte(data, '[')
+	if start < 0 {
+		return nil, fmt.Errorf("no tweets found in archive")
+	}
+
+	entries := []*archiveEntry{}
+	if err := json.Unmarshal(data[start:], &entries); err != nil {
+		return nil, fmt.Errorf("could not parse archive: %+v", err)
+	}
+
+	tweets := make([]*Tweet, 0, len(entries))
+	for _, e := range entries {
+		at := &e.archiveTweet
+		if e.Tweet != nil {
+			at = e.Tweet
+		}
+
+		t, err := at.toTweet(screenName)
+		if err != nil {
+			return nil, err
+		}
+
+		tweets = append(tweets, t)
+	}
+
+	return tweets, nil
+}
+
+// ImportTweetArchive parses a Twitter data export with ParseTweetArchive and
+// saves every tweet in it. Importing the same archive twice updates the
+// existing rows. It returns the number of tweets saved.
+func ImportTweetArchive(ctx context.Context, r io.Reader, screenName string) (int, error) {
+	tweets, err := ParseTweetArchive(r, screenName)
+	if err != nil {
+		return 0, err
+	}
+
+	// Archives are newest first. Save oldest first so replies can find the
+	// conversation of the tweet they reply to.
+	sort.SliceStable(tweets, func(i, j int) bool {
+		return tweets[i].Posted.Before(tweets[j].Posted)
+	})
+
+	for i, t := range tweets {
+		if err := t.Save(ctx); err != nil {
+			return i, fmt.Errorf("could not save tweet %s: %+v", t.ID, err)
+		}
+	}
+
+	return len(tweets), nil
+}
+
+func (at *archiveTweet) toTweet(screenName string) (*Tweet, error) {
+	if at.IDStr == "" {
+		return nil, fmt.Errorf("tweet in archive has no id")
+	}
+
+	posted, err := time.Parse(archiveTimeFormat, at.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse time for tweet %s: %+v", at.IDStr, err)
+	}
+
+	t := &Tweet{
+		ID:                  at.IDStr,
+		Text:                at.FullText,
+		ScreenName:          screenName,
+		FavoriteCount:       int(at.FavoriteCount),
+		RetweetCount:        int(at.RetweetCount),
+		Posted:              posted,
+		Hashtags:            []string{},
+		Symbols:             []string{},
+		UserMentions:        []string{},
+		Urls:                []URI{},
+		InReplyToStatusID:   at.InReplyToStatusIDStr,
+		InReplyToScreenName: at.InReplyToScreenName,
+		QuotedStatusID:      at.QuotedStatusIDStr,
+	}
+
+	if t.Text == "" {
+		t.Text = at.Text
+	}
+
+	for _, h := range at.Entities.Hashtags {
+		t.Hashtags = append(t.Hashtags, h.Text)
+	}
+
+	for _, s := range at.Entities.Symbols {
+		t.Symbols = append(t.Symbols, s.Text)
+	}
+
+	for _, m := range at.Entities.UserMentions {
+		t.UserMentions = appendUnique(t.UserMentions, m.ScreenName)
+	}
+
+	for _, u := range at.Entities.URLs {
+		t.Urls = append(t.Urls, NewURI(u.ExpandedURL))
+	}
+
+	// Replies only mention the user being replied to in the text if they are
+	// not trimmed, so make sure they are always in the mentions.
+	if at.InReplyToScreenName != "" {
+		t.UserMentions = appendUnique(t.UserMentions, at.InReplyToScreenName)
+	}
+
+	// Retweets are stored as "RT @user: text", and the favorite and retweet
+	// counts are those of the original tweet, not ours.
+	if strings.HasPrefix(t.Text, "RT @") {
+		if name := strings.SplitN(strings.TrimPrefix(t.Text, "RT @"), ":", 2)[0]; name != "" {
+			t.UserMentions = appendUnique(t.UserMentions, name)
+		}
+		t.FavoriteCount = 0
+		t.RetweetCount = 0
+	}
+
+	return t, nil
+}
+
+// archiveCount is a count in an archive, which may be a number, a string
+// holding a number, or an empty string when it is unset.
+type archiveCount int
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (c *archiveCount) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+
+	if s == "" || s == "null" {
+		*c = 0
+		return nil
+	}
+
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("invalid count %s", b)
+	}
+	*c = archiveCount(i)
+
+	return nil
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return list
+		}
+	}
+
+	return append(list, s)
+}
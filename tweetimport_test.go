@@ -0,0 +1,46 @@
+package graphql
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseTweetArchiveCounts(t *testing.T) {
+	archive := `window.YTD.tweet.part0 = [
+  {"tweet": {"id_str": "1", "full_text": "numbers", "created_at": "Wed Oct 10 20:19:24 +0000 2018", "favorite_count": 3, "retweet_count": 4}},
+  {"tweet": {"id_str": "2", "full_text": "strings", "created_at": "Wed Oct 10 20:19:24 +0000 2018", "favorite_count": "5", "retweet_count": "6"}},
+  {"tweet": {"id_str": "3", "full_text": "empty", "created_at": "Wed Oct 10 20:19:24 +0000 2018", "favorite_count": "", "retweet_count": ""}},
+  {"tweet": {"id_str": "4", "full_text": "missing", "created_at": "Wed Oct 10 20:19:24 +0000 2018"}}
+]`
+
+	tweets, err := ParseTweetArchive(strings.NewReader(archive), "icco")
+	if err != nil {
+		t.Fatalf("ParseTweetArchive: %+v", err)
+	}
+
+	want := map[string][2]int{
+		"1": {3, 4},
+		"2": {5, 6},
+		"3": {0, 0},
+		"4": {0, 0},
+	}
+
+	if len(tweets) != len(want) {
+		t.Fatalf("got %d tweets, want %d", len(tweets), len(want))
+	}
+
+	for _, tw := range tweets {
+		w := want[tw.ID]
+		if tw.FavoriteCount != w[0] || tw.RetweetCount != w[1] {
+			t.Errorf("tweet %s: got counts %d, %d, want %d, %d", tw.ID, tw.FavoriteCount, tw.RetweetCount, w[0], w[1])
+		}
+	}
+}
+
+func TestParseTweetArchiveInvalidCount(t *testing.T) {
+	archive := `[{"id_str": "1", "full_text": "bad", "created_at": "Wed Oct 10 20:19:24 +0000 2018", "favorite_count": "lots"}]`
+
+	if _, err := ParseTweetArchive(strings.NewReader(archive), "icco"); err == nil {
+		t.Error("expected an error for a count that is not a number")
+	}
+}
@@ -0,0 +1,57 @@
+package graphql
+
+import (
+	"context"
+)
+
+// TopHashtags returns the hashtags a screen name uses most, lowercased, with
+// how many tweets use each. A tweet that repeats a hashtag counts once.
+func TopHashtags(ctx context.Context, screenName string, limit, offset int) ([]*Count, error) {
+	query := `
+SELECT lower(h) AS tag, COUNT(DISTINCT id) AS cnt
+FROM tweets, UNNEST(hashtags) AS h
+WHERE screen_name = $1
+GROUP BY tag
+ORDER BY cnt DESC, tag ASC
+LIMIT $2 OFFSET $3`
+
+	return queryCounts(ctx, query, screenName, limit, offset)
+}
+
+// TopMentions returns the users a screen name mentions most, lowercased, with
+// how many tweets mention each. A tweet that repeats a mention counts once.
+func TopMentions(ctx context.Context, screenName string, limit, offset int) ([]*Count, error) {
+	query := `
+SELECT lower(m) AS mention, COUNT(DISTINCT id) AS cnt
+FROM tweets, UNNEST(user_mentions) AS m
+WHERE screen_name = $1
+GROUP BY mention
+ORDER BY cnt DESC, mention ASC
+LIMIT $2 OFFSET $3`
+
+	return queryCounts(ctx, query, screenName, limit, offset)
+}
+
+// MostFavoritedTweets returns a screen name's tweets with the most favorites.
+func MostFavoritedTweets(ctx context.Context, screenName string, limit, offset int) ([]*Tweet, error) {
+	return queryTweets(ctx, "SELECT "+tweetColumns+" FROM tweets WHERE screen_name = $1 ORDER BY favorites DESC, posted DESC LIMIT $2 OFFSET $3", screenName, limit, offset)
+}
+
+// MostRetweetedTweets returns a screen name's tweets with the most retweets.
+func MostRetweetedTweets(ctx context.Context, screenName string, limit, offset int) ([]*Tweet, error) {
+	return queryTweets(ctx, "SELECT "+tweetColumns+" FROM tweets WHERE screen_name = $1 ORDER BY retweets DESC, posted DESC LIMIT $2 OFFSET $3", screenName, limit, offset)
+}
+
+// TweetsPerMonth returns how many tweets a screen name posted in each month,
+// oldest first. Keys are of the form YYYY-MM in UTC. Months with no tweets are
+// left out.
+func TweetsPerMonth(ctx context.Context, screenName string) ([]*Count, error) {
+	query := `
+SELECT to_char(date_trunc('month', posted AT TIME ZONE 'UTC'), 'YYYY-MM') AS month, COUNT(*) AS cnt
+FROM tweets
+WHERE screen_name = $1
+GROUP BY month
+ORDER BY month ASC`
+
+	return queryCounts(ctx, query, screenName)
+}
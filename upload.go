@@ -0,0 +1,56 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+)
+
+type uploadsKey struct{}
+
+// Upload is a file sent with a GraphQL multipart request. The request's
+// variables only name the file, which is kept in the request's context until
+// Open is called.
+type Upload struct {
+	key string
+}
+
+// WithUploads returns a context holding the files of a multipart request,
+// keyed by the names they are given in the request's map.
+func WithUploads(ctx context.Context, files map[string]*multipart.FileHeader) context.Context {
+	return context.WithValue(ctx, uploadsKey{}, files)
+}
+
+// Open returns the uploaded file and its name. The caller must close it.
+func (u *Upload) Open(ctx context.Context) (multipart.File, string, error) {
+	files, _ := ctx.Value(uploadsKey{}).(map[string]*multipart.FileHeader)
+	fh, ok := files[u.key]
+	if !ok {
+		return nil, "", fmt.Errorf("no file uploaded as %q", u.key)
+	}
+
+	f, err := fh.Open()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return f, fh.Filename, nil
+}
+
+// UnmarshalGQL implements the graphql.Marshaler interface
+func (u *Upload) UnmarshalGQL(v interface{}) error {
+	key, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("Upload must be sent as a multipart request")
+	}
+	u.key = key
+
+	return nil
+}
+
+// MarshalGQL implements the graphql.Marshaler interface. Uploads can only be
+// inputs, so this is always null.
+func (u Upload) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, "null")
+}
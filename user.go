@@ -7,6 +7,10 @@ import (
 	"time"
 )
 
+// userColumns are the columns selected for every user query, in the order
+// scanUser expects them.
+const userColumns = "id, role, apikey, COALESCE(feed_token::text, ''), created_at, modified_at"
+
 // User is a database object based off of what we get back from Google OAuth.
 type User struct {
 	ID       string
@@ -14,6 +18,10 @@ type User struct {
 	APIKey   string
 	Created  time.Time
 	Modified time.Time
+
+	// FeedToken authenticates feeds, like the calendar of logs, that are
+	// fetched by apps which cannot send headers.
+	FeedToken string
 }
 
 // Empty tells us if the user is real.
@@ -21,6 +29,12 @@ func (u User) Empty() bool {
 	return u.ID == ""
 }
 
+// isViewer reports whether u is the current user.
+func (u *User) isViewer(ctx context.Context) bool {
+	viewer := GetUserFromContext(ctx)
+	return viewer != nil && viewer.ID == u.ID
+}
+
 // Save is an upsert based operation for User.
 func (u *User) Save(ctx context.Context) error {
 	_, err := db.ExecContext(ctx,
@@ -42,8 +56,8 @@ func (u *User) Save(ctx context.Context) error {
 // create it.
 func GetUser(ctx context.Context, id string) (*User, error) {
 	var user User
-	row := db.QueryRowContext(ctx, "SELECT id, role, apikey, created_at, modified_at FROM users WHERE id = $1", id)
-	err := row.Scan(&user.ID, &user.Role, &user.APIKey, &user.Created, &user.Modified)
+	row := db.QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE id = $1", id)
+	err := scanUser(row, &user)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -59,14 +73,59 @@ func GetUser(ctx context.Context, id string) (*User, error) {
 	}
 }
 
+// FindUser returns a user from the database. Unlike GetUser, it never creates
+// or updates the user, so it is safe to call for users other than the current
+// one.
+func FindUser(ctx context.Context, id string) (*User, error) {
+	var user User
+	row := db.QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE id = $1", id)
+	err := scanUser(row, &user)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("No user %s", id)
+	case err != nil:
+		return nil, fmt.Errorf("Error running get query: %+v", err)
+	default:
+		return &user, nil
+	}
+}
+
 // GetUserByAPIKey returns a user from the database.
 func GetUserByAPIKey(ctx context.Context, apikey string) (*User, error) {
 	var user User
-	row := db.QueryRowContext(ctx, "SELECT id, role, apikey, created_at, modified_at FROM users WHERE apikey = $1", apikey)
-	err := row.Scan(&user.ID, &user.Role, &user.APIKey, &user.Created, &user.Modified)
+	row := db.QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE apikey = $1", apikey)
+	err := scanUser(row, &user)
 	if err != nil {
 		return nil, fmt.Errorf("Error running get query: %+v", err)
 	}
 
 	return &user, (&user).Save(ctx)
 }
+
+// GetUserByFeedToken returns the user a feed token belongs to.
+func GetUserByFeedToken(ctx context.Context, token string) (*User, error) {
+	var user User
+	row := db.QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE feed_token = $1", token)
+	err := scanUser(row, &user)
+	if err != nil {
+		return nil, fmt.Errorf("Error running get query: %+v", err)
+	}
+
+	return &user, nil
+}
+
+// ResetFeedToken gives the user a new feed token, so that links with the old
+// one stop working.
+func (u *User) ResetFeedToken(ctx context.Context) error {
+	row := db.QueryRowContext(ctx, "UPDATE users SET (feed_token, modified_at) = (gen_random_uuid(), $2) WHERE id = $1 RETURNING feed_token::text", u.ID, time.Now())
+	return row.Scan(&u.FeedToken)
+}
+
+type userScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanUser(row userScanner, u *User) error {
+	return row.Scan(&u.ID, &u.Role, &u.APIKey, &u.FeedToken, &u.Created, &u.Modified)
+}
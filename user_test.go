@@ -0,0 +1,51 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUserSecretsOnlyForViewer(t *testing.T) {
+	u := &User{ID: "owner", Role: string(RoleNormal), APIKey: "key", FeedToken: "token"}
+	r := &userResolver{&Resolver{}}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want bool
+	}{
+		{"anonymous", context.Background(), false},
+		{"other user", WithUser(context.Background(), &User{ID: "other", Role: string(RoleNormal)}), false},
+		{"admin", WithUser(context.Background(), &User{ID: "admin", Role: string(RoleAdmin)}), false},
+		{"owner", WithUser(context.Background(), &User{ID: "owner", Role: string(RoleNormal)}), true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			key, err := r.Apikey(tc.ctx, u)
+			if err != nil {
+				t.Fatalf("Apikey: %+v", err)
+			}
+
+			token, err := r.FeedToken(tc.ctx, u)
+			if err != nil {
+				t.Fatalf("FeedToken: %+v", err)
+			}
+
+			if !tc.want {
+				if key != nil || token != nil {
+					t.Errorf("got %v, %v, want both hidden", key, token)
+				}
+				return
+			}
+
+			if key == nil || *key != "key" {
+				t.Errorf("Apikey = %v, want key", key)
+			}
+
+			if token == nil || *token != "token" {
+				t.Errorf("FeedToken = %v, want token", token)
+			}
+		})
+	}
+}